@@ -0,0 +1,54 @@
+package mbadocx
+
+import (
+	"fmt"
+
+	"github.com/didikprabowo/mbadocx/elements"
+	"github.com/didikprabowo/mbadocx/relationships"
+)
+
+// AddHTMLChunk embeds an HTML fragment that Word imports as native content
+// (paragraphs, tables, lists) when the document is opened.
+//
+// The HTML is stored as an alternative format part (word/afchunkN.html) and
+// referenced from the body with <w:altChunk>. Word performs the conversion, so
+// the imported content is not visible to other readers of this package (for
+// example WordCount) and applications without HTML import show nothing.
+//
+// Parameters:
+//   - html: An HTML document or fragment; fragments are wrapped in <html><body>
+//
+// Returns:
+//   - *elements.AltChunk: The inserted chunk
+//   - error: An error if the document has been closed or html is empty
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	_, err := doc.AddHTMLChunk(`<table border="1"><tr><th>Item</th><th>Qty</th></tr><tr><td>Apples</td><td>3</td></tr></table>`)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) AddHTMLChunk(html string) (*elements.AltChunk, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return nil, fmt.Errorf("document has been closed")
+	}
+	if html == "" {
+		return nil, fmt.Errorf("html chunk must not be empty")
+	}
+
+	chunk := elements.NewHTMLChunk(d.relationships.CountByType(relationships.TypeAltChunk)+1, html)
+
+	rel := d.relationships.AddAltChunk(chunk.FileName())
+	chunk.RelationshipID = rel.ID
+
+	d.contentTypes.AddOverride("/"+chunk.TargetPath()+chunk.FileName(), elements.ContentTypeHTML)
+
+	d.body.AddElement(chunk)
+	d.media.AddMedia(chunk)
+
+	return chunk, nil
+}
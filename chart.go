@@ -0,0 +1,222 @@
+package mbadocx
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/didikprabowo/mbadocx/elements"
+	"github.com/didikprabowo/mbadocx/relationships"
+)
+
+// AddChart inserts a native Word chart into the document.
+// The chart is stored as a separate chart part (word/charts/chartN.xml) together
+// with an embedded workbook, so the data stays editable from Word.
+//
+// Parameters:
+//   - chartType: The kind of chart to render (elements.ChartTypeBar,
+//     elements.ChartTypeLine or elements.ChartTypePie)
+//   - categories: The category labels shown on the X axis (or the pie slices)
+//   - series: Map of series name to values. Every series must have exactly one
+//     value per category. Series are ordered by name.
+//
+// Returns:
+//   - *elements.Chart: The created chart for further configuration (title, size)
+//   - error: An error if the document has been closed, the chart type is unknown or the data is inconsistent
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//
+//	chart, err := doc.AddChart(elements.ChartTypeBar,
+//	    []string{"Q1", "Q2", "Q3", "Q4"},
+//	    map[string][]float64{
+//	        "2024": {10, 12, 9, 15},
+//	        "2025": {11, 14, 13, 18},
+//	    })
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	chart.SetTitle("Revenue").SetSize(6, 3.5)
+func (d *Document) AddChart(chartType elements.ChartType, categories []string, series map[string][]float64) (*elements.Chart, error) {
+	names := make([]string, 0, len(series))
+	for name := range series {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	chartSeries := make([]elements.ChartSeries, 0, len(names))
+	for _, name := range names {
+		chartSeries = append(chartSeries, elements.ChartSeries{Name: name, Values: series[name]})
+	}
+
+	return d.addChart(chartType, categories, chartSeries)
+}
+
+// AddBarChart inserts a clustered column chart into the document.
+// See AddChart for details on the parameters.
+//
+// Example:
+//
+//	doc.AddBarChart([]string{"North", "South"}, map[string][]float64{"Sales": {120, 95}})
+func (d *Document) AddBarChart(categories []string, series map[string][]float64) (*elements.Chart, error) {
+	return d.AddChart(elements.ChartTypeBar, categories, series)
+}
+
+// AddLineChart inserts a line chart into the document.
+// See AddChart for details on the parameters.
+//
+// Example:
+//
+//	doc.AddLineChart([]string{"Jan", "Feb", "Mar"}, map[string][]float64{"Visitors": {300, 420, 510}})
+func (d *Document) AddLineChart(categories []string, series map[string][]float64) (*elements.Chart, error) {
+	return d.AddChart(elements.ChartTypeLine, categories, series)
+}
+
+// AddPieChart inserts a pie chart into the document.
+// Pie charts render a single series; only the first series (by name) is meaningful.
+//
+// Example:
+//
+//	doc.AddPieChart([]string{"Chrome", "Firefox", "Safari"}, map[string][]float64{"Share": {65, 20, 15}})
+func (d *Document) AddPieChart(categories []string, series map[string][]float64) (*elements.Chart, error) {
+	return d.AddChart(elements.ChartTypePie, categories, series)
+}
+
+// AddChartFromTable builds a chart from the contents of an existing table.
+// The first row is treated as the header row and the first column as the
+// category labels. Every other column whose data cells are all numeric becomes
+// a series named after its header cell; non-numeric columns are skipped.
+//
+// Common number formatting is accepted in cells: thousands separators,
+// currency symbols ($, €, £) and a trailing percent sign. Empty cells count as 0.
+//
+// Parameters:
+//   - t: The table holding the data (header row plus at least one data row)
+//   - chartType: "bar", "line" or "pie"
+//
+// Returns:
+//   - *elements.Chart: The created chart for further configuration
+//   - error: An error if the document has been closed, the table has no data rows or no numeric columns
+//
+// Example:
+//
+//	table := doc.AddTableWithHeaders(
+//	    []string{"Region", "Q1", "Q2"},
+//	    [][]string{
+//	        {"North", "$50,000", "$55,000"},
+//	        {"South", "$45,000", "$48,000"},
+//	    })
+//
+//	chart, err := doc.AddChartFromTable(table, "bar")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	chart.SetTitle("Revenue by Region")
+func (d *Document) AddChartFromTable(t *elements.Table, chartType string) (*elements.Chart, error) {
+	if t == nil || len(t.Rows) < 2 {
+		return nil, fmt.Errorf("table must have a header row and at least one data row")
+	}
+
+	header := t.Rows[0]
+	dataRows := t.Rows[1:]
+
+	categories := make([]string, 0, len(dataRows))
+	for i := range dataRows {
+		text, err := t.GetCellText(i+1, 0)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, strings.TrimSpace(text))
+	}
+
+	series := make([]elements.ChartSeries, 0, len(header.Cells)-1)
+	for col := 1; col < len(header.Cells); col++ {
+		name, _ := t.GetCellText(0, col)
+		values := make([]float64, 0, len(dataRows))
+
+		numeric := true
+		for i := range dataRows {
+			text, err := t.GetCellText(i+1, col)
+			if err != nil {
+				numeric = false
+				break
+			}
+
+			v, ok := parseChartNumber(text)
+			if !ok {
+				numeric = false
+				break
+			}
+			values = append(values, v)
+		}
+
+		if numeric {
+			series = append(series, elements.ChartSeries{Name: strings.TrimSpace(name), Values: values})
+		}
+	}
+
+	if len(series) == 0 {
+		return nil, fmt.Errorf("table has no numeric columns to chart")
+	}
+
+	return d.addChart(elements.ChartType(chartType), categories, series)
+}
+
+// parseChartNumber parses a table cell as a number, tolerating common formatting
+func parseChartNumber(text string) (float64, bool) {
+	s := strings.TrimSpace(text)
+	if s == "" {
+		return 0, true
+	}
+
+	s = strings.NewReplacer(",", "", "$", "", "€", "", "£", "", " ", "").Replace(s)
+	s = strings.TrimSuffix(s, "%")
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// addChart registers the chart parts, relationships and content types and
+// places the chart in a new paragraph.
+func (d *Document) addChart(chartType elements.ChartType, categories []string, series []elements.ChartSeries) (*elements.Chart, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return nil, fmt.Errorf("document has been closed")
+	}
+
+	index := d.relationships.CountByType(relationships.TypeChart) + 1
+
+	chart, err := elements.NewChart(index, chartType, categories, series)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := chart.Parts()
+	if err != nil {
+		return nil, err
+	}
+
+	rel := d.relationships.AddChart(fmt.Sprintf("charts/%s", chart.FileName()))
+	chart.RelationshipID = rel.ID
+
+	d.contentTypes.AddOverride("/word/charts/"+chart.FileName(), elements.ContentTypeChart)
+	d.contentTypes.AddDefault("xlsx", elements.ContentTypeXLSX)
+
+	p := elements.NewParagraph(d)
+	p.AddChildren(chart)
+	d.body.AddElement(p)
+
+	d.media.AddMedia(chart)
+	for _, part := range parts {
+		d.media.AddMedia(part)
+	}
+
+	return chart, nil
+}
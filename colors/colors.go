@@ -0,0 +1,76 @@
+// Package colors maps CSS and Word color names to RGB hex values.
+package colors
+
+import "strings"
+
+// names maps lowercase color names to 6-digit uppercase hex values
+var names = map[string]string{
+	// Basic CSS colors
+	"black":   "000000",
+	"white":   "FFFFFF",
+	"red":     "FF0000",
+	"green":   "008000",
+	"lime":    "00FF00",
+	"blue":    "0000FF",
+	"yellow":  "FFFF00",
+	"cyan":    "00FFFF",
+	"aqua":    "00FFFF",
+	"magenta": "FF00FF",
+	"fuchsia": "FF00FF",
+	"gray":    "808080",
+	"grey":    "808080",
+	"silver":  "C0C0C0",
+	"maroon":  "800000",
+	"olive":   "808000",
+	"navy":    "000080",
+	"purple":  "800080",
+	"teal":    "008080",
+
+	// Extended CSS colors
+	"orange":     "FFA500",
+	"pink":       "FFC0CB",
+	"brown":      "A52A2A",
+	"gold":       "FFD700",
+	"indigo":     "4B0082",
+	"violet":     "EE82EE",
+	"coral":      "FF7F50",
+	"salmon":     "FA8072",
+	"crimson":    "DC143C",
+	"tomato":     "FF6347",
+	"khaki":      "F0E68C",
+	"beige":      "F5F5DC",
+	"ivory":      "FFFFF0",
+	"lavender":   "E6E6FA",
+	"turquoise":  "40E0D0",
+	"skyblue":    "87CEEB",
+	"lightblue":  "ADD8E6",
+	"lightgreen": "90EE90",
+	"lightgray":  "D3D3D3",
+	"lightgrey":  "D3D3D3",
+	"lightpink":  "FFB6C1",
+	"lightcyan":  "E0FFFF",
+	"darkred":    "8B0000",
+	"darkgreen":  "006400",
+	"darkblue":   "00008B",
+	"darkcyan":   "008B8B",
+	"darkgray":   "A9A9A9",
+	"darkgrey":   "A9A9A9",
+	"darkorange": "FF8C00",
+	"royalblue":  "4169E1",
+	"steelblue":  "4682B4",
+	"slategray":  "708090",
+	"slategrey":  "708090",
+
+	// Word highlight names not covered above
+	"darkmagenta": "8B008B",
+	"darkyellow":  "808000",
+}
+
+// Hex returns the hex value of a named color. Names are case-insensitive and
+// may contain spaces, hyphens or underscores ("Light Blue", "light-blue").
+func Hex(name string) (string, bool) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	key = strings.NewReplacer(" ", "", "-", "", "_", "").Replace(key)
+	hex, ok := names[key]
+	return hex, ok
+}
@@ -28,13 +28,7 @@ func NewDefaultContentType() *ContentTypes {
 		Defaults: []Default{
 			{Extension: "rels", ContentType: "application/vnd.openxmlformats-package.relationships+xml"},
 			{Extension: "xml", ContentType: "application/xml"},
-			{Extension: "png", ContentType: "image/png"},
-			{Extension: "jpeg", ContentType: "image/jpeg"},
-			{Extension: "jpg", ContentType: "image/jpeg"},
-			{Extension: "gif", ContentType: "image/gif"},
-			{Extension: "bmp", ContentType: "image/bmp"},
-			{Extension: "tiff", ContentType: "image/tiff"},
-			{Extension: "tif", ContentType: "image/tiff"},
+			// Image extensions are added by the writer for the media actually used
 		},
 		Overrides: []Override{
 			{PartName: "/word/document.xml", ContentType: "application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"},
@@ -54,3 +48,23 @@ func NewDefaultContentType() *ContentTypes {
 func (ct *ContentTypes) Get() *ContentTypes {
 	return ct
 }
+
+// AddDefault registers a MIME type for a file extension if it is not already present
+func (ct *ContentTypes) AddDefault(extension, contentType string) {
+	for _, d := range ct.Defaults {
+		if d.Extension == extension {
+			return
+		}
+	}
+	ct.Defaults = append(ct.Defaults, Default{Extension: extension, ContentType: contentType})
+}
+
+// AddOverride registers a MIME type for a part name if it is not already present
+func (ct *ContentTypes) AddOverride(partName, contentType string) {
+	for _, o := range ct.Overrides {
+		if o.PartName == partName {
+			return
+		}
+	}
+	ct.Overrides = append(ct.Overrides, Override{PartName: partName, ContentType: contentType})
+}
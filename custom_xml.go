@@ -0,0 +1,66 @@
+package mbadocx
+
+import (
+	"fmt"
+
+	"github.com/didikprabowo/mbadocx/elements"
+	"github.com/didikprabowo/mbadocx/relationships"
+)
+
+// AddCustomXML stores an XML data part in the document (customXml/itemN.xml)
+// so content controls can bind to its nodes. Word shows the bound node values
+// and writes edits made in the controls back to the part.
+//
+// Parameters:
+//   - id: Store item ID as a GUID (with or without braces); empty generates one
+//   - data: A well-formed XML document
+//
+// Returns:
+//   - *elements.CustomXML: The part, whose StoreItemID is used for binding
+//   - error: An error if the data is not well-formed XML, the ID is not a GUID or
+//     a part with the same ID has already been added
+//
+// Example:
+//
+//	part, err := doc.AddCustomXML("", []byte(`<invoice><customer>ACME Corp</customer></invoice>`))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//
+//	p := doc.AddParagraph()
+//	p.AddText("Bill to: ")
+//	p.AddBoundText(part, "/invoice[1]/customer[1]", "ACME Corp")
+func (d *Document) AddCustomXML(id string, data []byte) (*elements.CustomXML, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return nil, fmt.Errorf("document has been closed")
+	}
+
+	index := d.relationships.CountByType(relationships.TypeCustomXML) + 1
+
+	part, err := elements.NewCustomXML(index, id, data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Bindings name the part by its store item ID, so it must be unique
+	for _, media := range d.media.Media {
+		if existing, ok := media.(*elements.CustomXML); ok && existing.StoreItemID == part.StoreItemID {
+			return nil, fmt.Errorf("custom XML part with store item ID %s already exists", part.StoreItemID)
+		}
+	}
+
+	rel := d.relationships.AddCustomXML("../" + part.TargetPath() + part.FileName())
+	part.RelationshipID = rel.ID
+
+	d.contentTypes.AddOverride("/"+part.TargetPath()+part.PropsFileName(), elements.ContentTypeCustomXMLProps)
+
+	d.media.AddMedia(part)
+	for _, p := range part.Parts() {
+		d.media.AddMedia(p)
+	}
+
+	return part, nil
+}
@@ -33,32 +33,48 @@
 package mbadocx
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"reflect"
 	"sync"
 	"time"
 
 	ct "github.com/didikprabowo/mbadocx/content_types"
+	"github.com/didikprabowo/mbadocx/elements"
 	"github.com/didikprabowo/mbadocx/metadata"
+	"github.com/didikprabowo/mbadocx/properties"
 	"github.com/didikprabowo/mbadocx/relationships"
+	"github.com/didikprabowo/mbadocx/settings"
 	"github.com/didikprabowo/mbadocx/styles"
 	"github.com/didikprabowo/mbadocx/types"
 	"github.com/didikprabowo/mbadocx/writer"
+	"github.com/google/uuid"
 )
 
 // Document represents a DOCX document and its core components.
 type Document struct {
 	// Core components
-	contentTypes  *ct.ContentTypes             // Content types for the DOCX package
-	body          *Body                        // Main document body
-	relationships *relationships.Relationships // Relationships (e.g., images, styles)
-	styles        *styles.Styles               // Document styles
+	contentTypes  *ct.ContentTypes              // Content types for the DOCX package
+	body          *Body                         // Main document body
+	relationships *relationships.Relationships  // Relationships (e.g., images, styles)
+	styles        *styles.Styles                // Document styles
+	settings      *settings.Settings            // Document settings (zoom, tab stops, background)
+	section       *properties.SectionProperties // Page setup of the final (body) section
 
 	// Metadata
 	metadata *metadata.Metadata // Document metadata (author, timestamps, etc.)
 	media    *Media
 
+	footnotes  *elements.Footnotes       // Footnotes part, created by the first AddFootnote
+	toc        *elements.TableOfContents // Table of contents whose headings get bookmarks
+	bookmarkID int                       // Last bookmark ID handed out
+	lists      []writer.AbstractNum      // Lists defined with DefineList, in numbering ID order
+
+	logger writer.Logger // Optional logger for save progress; nil means silent
+
 	// Internal state
 	mu     sync.RWMutex // Mutex for thread safety
 	closed bool         // Indicates if the document is closed
@@ -75,6 +91,8 @@ func New() *Document {
 		contentTypes:  ct.NewDefaultContentType(),
 		metadata:      metadata.NewDefaultMetadata(),
 		styles:        styles.NewDefaultStyles(),
+		settings:      settings.NewDefaultSettings(),
+		section:       properties.NewSectionProperties(),
 		openFiles:     make([]*os.File, 0),
 		media:         &Media{},
 		closed:        false,
@@ -119,6 +137,85 @@ func (d *Document) Save(filename string) (err error) {
 	return
 }
 
+// SaveContext writes the document to filename, aborting when ctx is cancelled.
+//
+// The document is first written to a temporary file in the same directory and
+// renamed into place once complete, so a cancelled or failed save never leaves
+// a partial file behind and never replaces an existing file with a broken one.
+//
+// Parameters:
+//   - ctx: Context checked between zip entries; cancellation stops generation
+//   - filename: Destination path of the .docx file
+//
+// Returns:
+//   - error: ctx.Err() if the context was cancelled, or any write/rename error
+//
+// Example:
+//
+//	func handler(w http.ResponseWriter, r *http.Request) {
+//	    doc := buildReport()
+//	    if err := doc.SaveContext(r.Context(), "/var/reports/report.docx"); err != nil {
+//	        http.Error(w, err.Error(), http.StatusInternalServerError)
+//	        return
+//	    }
+//	}
+func (d *Document) SaveContext(ctx context.Context, filename string) (err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// Created with 0666 like os.Create, so a new document gets the umask's permissions
+	tmpName := filepath.Join(filepath.Dir(filename), "."+filepath.Base(filename)+"."+uuid.NewString()+".tmp")
+	file, err := os.OpenFile(tmpName, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0666)
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+
+	// Remove the temp file unless it was successfully renamed into place
+	defer func() {
+		if err != nil {
+			_ = file.Close()
+			_ = os.Remove(tmpName)
+		}
+	}()
+
+	if err = d.writeContext(ctx, file); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return fmt.Errorf("failed to write document: %w", err)
+	}
+
+	// Keep the permissions of the document being replaced
+	if info, statErr := os.Stat(filename); statErr == nil {
+		if err = file.Chmod(info.Mode().Perm()); err != nil {
+			return fmt.Errorf("failed to set file permissions: %w", err)
+		}
+	}
+
+	// Flush to disk so a crash after the rename cannot leave a truncated document
+	if err = file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync temp file: %w", err)
+	}
+
+	if err = file.Close(); err != nil {
+		return fmt.Errorf("failed to close temp file: %w", err)
+	}
+
+	if err = os.Rename(tmpName, filename); err != nil {
+		return fmt.Errorf("failed to move document into place: %w", err)
+	}
+
+	return nil
+}
+
 // SaveAs is an alias for Save, writes the document to a new file.
 func (d *Document) SaveAs(filename string) error {
 	return d.Save(filename)
@@ -138,19 +235,61 @@ func (d *Document) Write(w io.Writer) error {
 
 // write is the internal write method (must be called with lock held).
 func (d *Document) write(w io.Writer) error {
+	return d.writeContext(context.Background(), w)
+}
+
+// writeContext is the cancellable internal write method (must be called with lock held).
+func (d *Document) writeContext(ctx context.Context, w io.Writer) error {
 	// Set modified time during write
 	d.metadata.Modified = time.Now()
 
-	docWriter := writer.NewWriter(d)
+	docWriter := writer.NewWriter(d).SetLogger(d.logger)
 
 	// Write the document
-	if err := docWriter.Write(w); err != nil {
+	if err := docWriter.WriteContext(ctx, w); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// SetLogger sets a logger that reports each part written during Save.
+//
+// Documents are silent by default; nothing is logged unless a logger is set.
+//
+// Parameters:
+//   - logger: Any value with a Printf method such as *log.Logger; nil, including a nil
+//     *log.Logger or other typed nil pointer, disables logging
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	doc.SetLogger(log.New(os.Stderr, "docx: ", log.LstdFlags))
+//	doc.Save("report.docx") // logs "'word/document.xml' has been created." etc.
+func (d *Document) SetLogger(logger writer.Logger) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if isNilLogger(logger) {
+		logger = nil
+	}
+	d.logger = logger
+}
+
+// isNilLogger reports whether logger is nil or wraps a nil value, such as a
+// nil *log.Logger, which would panic on the first Printf
+func isNilLogger(logger writer.Logger) bool {
+	if logger == nil {
+		return true
+	}
+	v := reflect.ValueOf(logger)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Func, reflect.Map, reflect.Slice, reflect.Chan, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
+
 // Close releases all resources associated with the document.
 func (d *Document) Close() error {
 	d.mu.Lock()
@@ -178,6 +317,11 @@ func (d *Document) Close() error {
 	d.contentTypes = nil
 	d.metadata = nil
 	d.styles = nil
+	d.settings = nil
+	d.section = nil
+	d.footnotes = nil
+	d.toc = nil
+	d.lists = nil
 
 	d.closed = true
 
@@ -236,6 +380,27 @@ func (d *Document) ContentTypes() types.ContentTypes {
 	return d.contentTypes
 }
 
+// Settings returns the document settings.
+func (d *Document) Settings() types.Settings {
+	if d.closed {
+		return nil
+	}
+	return d.settings
+}
+
+// Section returns the page setup of the document's final section.
+func (d *Document) Section() *properties.SectionProperties {
+	if d.closed {
+		return nil
+	}
+	return d.section
+}
+
+// ListDefinitions returns the lists defined with DefineList.
+func (d *Document) ListDefinitions() []writer.AbstractNum {
+	return d.lists
+}
+
 // Media
 func (d *Document) Media() []types.Media {
 	return d.media.Media
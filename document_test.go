@@ -0,0 +1,107 @@
+package mbadocx
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// newLargeDocument builds a document with headings, formatted paragraphs and tables
+func newLargeDocument(paragraphs int) *Document {
+	doc := New()
+	for i := 0; i < paragraphs; i++ {
+		if i%100 == 0 {
+			doc.AddHeading(fmt.Sprintf("Chapter %d", i/100+1), 1)
+		}
+
+		p := doc.AddParagraph()
+		p.AddText(fmt.Sprintf("Paragraph %d with plain text, ", i))
+		p.AddText("bold text").SetBold(true)
+		p.AddText(" and italic text.").SetItalic(true)
+
+		if i%500 == 0 {
+			doc.AddTableWithHeaders(
+				[]string{"Name", "Quantity", "Price"},
+				[][]string{{"Widget", "4", "2.50"}, {"Gadget", "1", "10.00"}},
+			)
+		}
+	}
+	return doc
+}
+
+// readParts returns the content of every part of a .docx package by name
+func readParts(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("zip.NewReader() error = %v", err)
+	}
+
+	parts := make(map[string][]byte, len(zr.File))
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", f.Name, err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("read %s: %v", f.Name, err)
+		}
+		parts[f.Name] = content
+	}
+	return parts
+}
+
+func TestWriteParallelMatchesSerial(t *testing.T) {
+	doc := newLargeDocument(2000)
+
+	write := func(procs int) []byte {
+		defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(procs))
+
+		var buf bytes.Buffer
+		if err := doc.Write(&buf); err != nil {
+			t.Fatalf("Write() with GOMAXPROCS=%d error = %v", procs, err)
+		}
+		return buf.Bytes()
+	}
+
+	serial := readParts(t, write(1))
+	parallel := readParts(t, write(8))
+
+	if len(serial) != len(parallel) {
+		t.Fatalf("serial write has %d parts, parallel write has %d", len(serial), len(parallel))
+	}
+	for name, want := range serial {
+		// The modified time is stamped on every write
+		if name == "docProps/core.xml" {
+			continue
+		}
+		got, ok := parallel[name]
+		if !ok {
+			t.Errorf("parallel write is missing %s", name)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s differs between serial and parallel writes", name)
+		}
+	}
+}
+
+func BenchmarkSave(b *testing.B) {
+	doc := newLargeDocument(20000)
+	path := filepath.Join(b.TempDir(), "large.docx")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := doc.Save(path); err != nil {
+			b.Fatalf("Save() error = %v", err)
+		}
+	}
+}
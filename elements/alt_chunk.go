@@ -0,0 +1,70 @@
+package elements
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/didikprabowo/mbadocx/types"
+)
+
+// ContentTypeHTML is the content type of an HTML alternative format chunk
+const ContentTypeHTML = "text/html"
+
+var (
+	_ types.Element = (*AltChunk)(nil)
+	_ types.Media   = (*AltChunk)(nil)
+)
+
+// AltChunk imports content in another format (HTML) that Word converts to
+// native document content when the file is opened
+type AltChunk struct {
+	RelationshipID string // Relationship ID in document.xml.rels
+	Index          int    // Part number, N in word/afchunkN.html
+	Content        []byte // The imported content
+}
+
+// NewHTMLChunk creates an HTML chunk. Fragments without an <html> element are wrapped in a minimal document.
+func NewHTMLChunk(index int, html string) *AltChunk {
+	if !strings.Contains(strings.ToLower(html), "<html") {
+		html = `<!DOCTYPE html><html><head><meta charset="utf-8"></head><body>` + html + `</body></html>`
+	}
+	return &AltChunk{
+		Index:   index,
+		Content: []byte(html),
+	}
+}
+
+// Type returns the element type
+func (a *AltChunk) Type() string {
+	return "altChunk"
+}
+
+// XML generates the altChunk reference
+func (a *AltChunk) XML() ([]byte, error) {
+	return []byte(fmt.Sprintf(`<w:altChunk r:id="%s"/>`, a.RelationshipID)), nil
+}
+
+// RelID returns the relationship ID
+func (a *AltChunk) RelID() string {
+	return a.RelationshipID
+}
+
+// RelType returns the relationship type
+func (a *AltChunk) RelType() string {
+	return "http://schemas.openxmlformats.org/officeDocument/2006/relationships/aFChunk"
+}
+
+// TargetPath returns the directory of the part inside the package
+func (a *AltChunk) TargetPath() string {
+	return "word/"
+}
+
+// FileName returns the chunk file name
+func (a *AltChunk) FileName() string {
+	return fmt.Sprintf("afchunk%d.html", a.Index)
+}
+
+// RawContent returns the imported content
+func (a *AltChunk) RawContent() []byte {
+	return a.Content
+}
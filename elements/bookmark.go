@@ -0,0 +1,57 @@
+package elements
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BookmarkStart marks the beginning of a named bookmark
+type BookmarkStart struct {
+	ID   int    // Bookmark ID, unique within the document
+	Name string // Bookmark name used by hyperlinks and fields
+}
+
+// BookmarkEnd marks the end of the bookmark with the same ID
+type BookmarkEnd struct {
+	ID int
+}
+
+// Type returns the element type
+func (b *BookmarkStart) Type() string {
+	return "bookmarkStart"
+}
+
+// XML generates the bookmark start
+func (b *BookmarkStart) XML() ([]byte, error) {
+	return []byte(fmt.Sprintf(`<w:bookmarkStart w:id="%d" w:name="%s"/>`, b.ID, escapeXMLAttribute(b.Name))), nil
+}
+
+// Type returns the element type
+func (b *BookmarkEnd) Type() string {
+	return "bookmarkEnd"
+}
+
+// XML generates the bookmark end
+func (b *BookmarkEnd) XML() ([]byte, error) {
+	return []byte(fmt.Sprintf(`<w:bookmarkEnd w:id="%d"/>`, b.ID)), nil
+}
+
+// AddBookmark places a bookmark around the current content of the paragraph
+func (p *Paragraph) AddBookmark(id int, name string) *Paragraph {
+	children := make([]ParagraphChild, 0, len(p.Children)+2)
+	children = append(children, &BookmarkStart{ID: id, Name: name})
+	children = append(children, p.Children...)
+	children = append(children, &BookmarkEnd{ID: id})
+	p.Children = children
+	return p
+}
+
+// BookmarkWithPrefix returns the name of the first bookmark starting with prefix, or ""
+func (p *Paragraph) BookmarkWithPrefix(prefix string) string {
+	for _, child := range p.Children {
+		if b, ok := child.(*BookmarkStart); ok && strings.HasPrefix(b.Name, prefix) {
+			return b.Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,39 @@
+// elements/buffer_pool.go
+package elements
+
+import (
+	"bytes"
+	"sync"
+)
+
+// maxPooledBufferSize caps the buffers kept in the pool so one huge element
+// (e.g. a large table) does not pin its memory for the rest of the process
+const maxPooledBufferSize = 64 << 10
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+// getBuffer borrows an empty buffer from the pool
+func getBuffer() *bytes.Buffer {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	return buf
+}
+
+// putBuffer returns a buffer to the pool
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBufferSize {
+		return
+	}
+	bufferPool.Put(buf)
+}
+
+// detachBytes copies the buffer contents so the buffer can be returned to the pool
+func detachBytes(buf *bytes.Buffer) []byte {
+	out := make([]byte, buf.Len())
+	copy(out, buf.Bytes())
+	return out
+}
@@ -0,0 +1,311 @@
+// elements/chart.go
+package elements
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/didikprabowo/mbadocx/relationships"
+	"github.com/didikprabowo/mbadocx/types"
+)
+
+var (
+	_ types.Element = (*Chart)(nil)
+	_ types.Media   = (*Chart)(nil)
+)
+
+// ChartType defines the kind of chart to render
+type ChartType string
+
+const (
+	ChartTypeBar  ChartType = "bar"
+	ChartTypeLine ChartType = "line"
+	ChartTypePie  ChartType = "pie"
+)
+
+// Chart content types
+const (
+	ContentTypeChart = "application/vnd.openxmlformats-officedocument.drawingml.chart+xml"
+	ContentTypeXLSX  = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// Axis IDs shared between the plot and its axes
+const (
+	chartCategoryAxisID = 500000001
+	chartValueAxisID    = 500000002
+)
+
+// ChartSeries represents a named series of values
+type ChartSeries struct {
+	Name   string
+	Values []float64
+}
+
+// Chart represents a chart embedded as a separate chart part (word/charts/chartN.xml)
+type Chart struct {
+	RelationshipID string
+	Index          int // Chart number used for the part names (chart1.xml, ...)
+	ChartType      ChartType
+	Title          string
+	Categories     []string
+	Series         []ChartSeries
+	Width          int64 // Width in EMUs
+	Height         int64 // Height in EMUs
+}
+
+// NewChart creates a new chart with the given categories and series
+func NewChart(index int, chartType ChartType, categories []string, series []ChartSeries) (*Chart, error) {
+	switch chartType {
+	case ChartTypeBar, ChartTypeLine, ChartTypePie:
+	default:
+		return nil, fmt.Errorf("unsupported chart type: %s", chartType)
+	}
+
+	if len(categories) == 0 {
+		return nil, fmt.Errorf("chart must have at least one category")
+	}
+
+	if len(series) == 0 {
+		return nil, fmt.Errorf("chart must have at least one series")
+	}
+
+	for _, s := range series {
+		if len(s.Values) != len(categories) {
+			return nil, fmt.Errorf("series %q has %d values, expected %d", s.Name, len(s.Values), len(categories))
+		}
+	}
+
+	return &Chart{
+		Index:      index,
+		ChartType:  chartType,
+		Categories: categories,
+		Series:     series,
+		Width:      6 * EmusPerInch,
+		Height:     int64(3.5 * float64(EmusPerInch)),
+	}, nil
+}
+
+// Type returns the element type
+func (c *Chart) Type() string {
+	return "chart"
+}
+
+// RelID returns the relationship ID
+func (c *Chart) RelID() string {
+	return c.RelationshipID
+}
+
+// RelType returns the relationship type
+func (c *Chart) RelType() string {
+	return relationships.TypeChart
+}
+
+// TargetPath returns the target path for the chart part
+func (c *Chart) TargetPath() string {
+	return "word/charts/"
+}
+
+// FileName returns the chart part file name
+func (c *Chart) FileName() string {
+	return fmt.Sprintf("chart%d.xml", c.Index)
+}
+
+// RawContent returns the chart part XML
+func (c *Chart) RawContent() []byte {
+	return c.chartSpaceXML()
+}
+
+// WorkbookName returns the file name of the embedded workbook
+func (c *Chart) WorkbookName() string {
+	return fmt.Sprintf("Microsoft_Excel_Worksheet%d.xlsx", c.Index)
+}
+
+// SetTitle sets the chart title
+func (c *Chart) SetTitle(title string) *Chart {
+	c.Title = title
+	return c
+}
+
+// SetSize sets the chart size in inches
+func (c *Chart) SetSize(widthInches, heightInches float64) *Chart {
+	c.Width = int64(widthInches * float64(EmusPerInch))
+	c.Height = int64(heightInches * float64(EmusPerInch))
+	return c
+}
+
+// Parts returns the supporting package parts of the chart: its relationships
+// part and the embedded workbook holding the editable data
+func (c *Chart) Parts() ([]*Part, error) {
+	workbook, err := buildChartWorkbook(c.Categories, c.Series)
+	if err != nil {
+		return nil, fmt.Errorf("building chart workbook: %w", err)
+	}
+
+	rels := xml.Header +
+		`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/package"` +
+		` Target="../embeddings/` + c.WorkbookName() + `"/>` +
+		`</Relationships>`
+
+	return []*Part{
+		NewPart("word/charts/_rels/", c.FileName()+".rels", []byte(rels)),
+		NewPart("word/embeddings/", c.WorkbookName(), workbook),
+	}, nil
+}
+
+// XML generates the inline drawing that references the chart part
+func (c *Chart) XML() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString(`<w:r><w:drawing>`)
+	buf.WriteString(`<wp:inline distT="0" distB="0" distL="0" distR="0">`)
+	buf.WriteString(fmt.Sprintf(`<wp:extent cx="%d" cy="%d"/>`, c.Width, c.Height))
+	buf.WriteString(`<wp:effectExtent l="0" t="0" r="0" b="0"/>`)
+	buf.WriteString(fmt.Sprintf(`<wp:docPr id="%d" name="Chart %d"/>`, generateID(), c.Index))
+	buf.WriteString(`<wp:cNvGraphicFramePr/>`)
+	buf.WriteString(`<a:graphic xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main">`)
+	buf.WriteString(`<a:graphicData uri="http://schemas.openxmlformats.org/drawingml/2006/chart">`)
+	buf.WriteString(fmt.Sprintf(`<c:chart xmlns:c="http://schemas.openxmlformats.org/drawingml/2006/chart" r:id="%s"/>`, c.RelationshipID))
+	buf.WriteString(`</a:graphicData>`)
+	buf.WriteString(`</a:graphic>`)
+	buf.WriteString(`</wp:inline>`)
+	buf.WriteString(`</w:drawing></w:r>`)
+
+	return buf.Bytes(), nil
+}
+
+// chartSpaceXML generates the content of the chart part
+func (c *Chart) chartSpaceXML() []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString(xml.Header)
+	buf.WriteString(`<c:chartSpace xmlns:c="http://schemas.openxmlformats.org/drawingml/2006/chart"`)
+	buf.WriteString(` xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main"`)
+	buf.WriteString(` xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">`)
+	buf.WriteString(`<c:roundedCorners val="0"/>`)
+	buf.WriteString(`<c:chart>`)
+
+	// Title
+	if c.Title != "" {
+		buf.WriteString(`<c:title><c:tx><c:rich><a:bodyPr/><a:lstStyle/><a:p><a:r><a:t>`)
+		_ = xml.EscapeText(&buf, []byte(c.Title))
+		buf.WriteString(`</a:t></a:r></a:p></c:rich></c:tx><c:overlay val="0"/></c:title>`)
+		buf.WriteString(`<c:autoTitleDeleted val="0"/>`)
+	} else {
+		buf.WriteString(`<c:autoTitleDeleted val="1"/>`)
+	}
+
+	// Plot area
+	buf.WriteString(`<c:plotArea><c:layout/>`)
+	switch c.ChartType {
+	case ChartTypeLine:
+		buf.WriteString(`<c:lineChart><c:grouping val="standard"/><c:varyColors val="0"/>`)
+		c.writeSeriesXML(&buf)
+		buf.WriteString(`<c:marker val="1"/>`)
+		c.writeAxisIDsXML(&buf)
+		buf.WriteString(`</c:lineChart>`)
+		c.writeAxesXML(&buf)
+	case ChartTypePie:
+		buf.WriteString(`<c:pieChart><c:varyColors val="1"/>`)
+		c.writeSeriesXML(&buf)
+		buf.WriteString(`<c:firstSliceAng val="0"/>`)
+		buf.WriteString(`</c:pieChart>`)
+	default:
+		buf.WriteString(`<c:barChart><c:barDir val="col"/><c:grouping val="clustered"/><c:varyColors val="0"/>`)
+		c.writeSeriesXML(&buf)
+		buf.WriteString(`<c:gapWidth val="150"/>`)
+		c.writeAxisIDsXML(&buf)
+		buf.WriteString(`</c:barChart>`)
+		c.writeAxesXML(&buf)
+	}
+	buf.WriteString(`</c:plotArea>`)
+
+	// Legend
+	buf.WriteString(`<c:legend><c:legendPos val="r"/><c:overlay val="0"/></c:legend>`)
+	buf.WriteString(`<c:plotVisOnly val="1"/>`)
+	buf.WriteString(`<c:dispBlanksAs val="gap"/>`)
+	buf.WriteString(`</c:chart>`)
+
+	// Embedded workbook with the editable data (see Parts)
+	buf.WriteString(`<c:externalData r:id="rId1"><c:autoUpdate val="0"/></c:externalData>`)
+	buf.WriteString(`</c:chartSpace>`)
+
+	return buf.Bytes()
+}
+
+// writeSeriesXML writes all series with their cached values and workbook references
+func (c *Chart) writeSeriesXML(buf *bytes.Buffer) {
+	lastRow := len(c.Categories) + 1
+	categoryRef := fmt.Sprintf("Sheet1!$A$2:$A$%d", lastRow)
+
+	for i, s := range c.Series {
+		col := columnName(i + 1)
+
+		buf.WriteString(`<c:ser>`)
+		buf.WriteString(fmt.Sprintf(`<c:idx val="%d"/><c:order val="%d"/>`, i, i))
+
+		// Series name
+		buf.WriteString(fmt.Sprintf(`<c:tx><c:strRef><c:f>Sheet1!$%s$1</c:f>`, col))
+		buf.WriteString(`<c:strCache><c:ptCount val="1"/><c:pt idx="0"><c:v>`)
+		_ = xml.EscapeText(buf, []byte(s.Name))
+		buf.WriteString(`</c:v></c:pt></c:strCache></c:strRef></c:tx>`)
+
+		if c.ChartType == ChartTypeLine {
+			buf.WriteString(`<c:marker><c:symbol val="none"/></c:marker>`)
+		}
+
+		// Categories
+		buf.WriteString(fmt.Sprintf(`<c:cat><c:strRef><c:f>%s</c:f>`, categoryRef))
+		buf.WriteString(fmt.Sprintf(`<c:strCache><c:ptCount val="%d"/>`, len(c.Categories)))
+		for j, category := range c.Categories {
+			buf.WriteString(fmt.Sprintf(`<c:pt idx="%d"><c:v>`, j))
+			_ = xml.EscapeText(buf, []byte(category))
+			buf.WriteString(`</c:v></c:pt>`)
+		}
+		buf.WriteString(`</c:strCache></c:strRef></c:cat>`)
+
+		// Values
+		buf.WriteString(fmt.Sprintf(`<c:val><c:numRef><c:f>Sheet1!$%s$2:$%s$%d</c:f>`, col, col, lastRow))
+		buf.WriteString(fmt.Sprintf(`<c:numCache><c:formatCode>General</c:formatCode><c:ptCount val="%d"/>`, len(s.Values)))
+		for j, v := range s.Values {
+			buf.WriteString(fmt.Sprintf(`<c:pt idx="%d"><c:v>%s</c:v></c:pt>`, j, formatChartValue(v)))
+		}
+		buf.WriteString(`</c:numCache></c:numRef></c:val>`)
+
+		if c.ChartType == ChartTypeLine {
+			buf.WriteString(`<c:smooth val="0"/>`)
+		}
+
+		buf.WriteString(`</c:ser>`)
+	}
+}
+
+// writeAxisIDsXML writes the axis references of a bar or line plot
+func (c *Chart) writeAxisIDsXML(buf *bytes.Buffer) {
+	buf.WriteString(fmt.Sprintf(`<c:axId val="%d"/><c:axId val="%d"/>`, chartCategoryAxisID, chartValueAxisID))
+}
+
+// writeAxesXML writes the category and value axes of a bar or line plot
+func (c *Chart) writeAxesXML(buf *bytes.Buffer) {
+	buf.WriteString(`<c:catAx>`)
+	buf.WriteString(fmt.Sprintf(`<c:axId val="%d"/>`, chartCategoryAxisID))
+	buf.WriteString(`<c:scaling><c:orientation val="minMax"/></c:scaling>`)
+	buf.WriteString(`<c:delete val="0"/><c:axPos val="b"/>`)
+	buf.WriteString(`<c:numFmt formatCode="General" sourceLinked="1"/>`)
+	buf.WriteString(`<c:majorTickMark val="out"/><c:minorTickMark val="none"/><c:tickLblPos val="nextTo"/>`)
+	buf.WriteString(fmt.Sprintf(`<c:crossAx val="%d"/>`, chartValueAxisID))
+	buf.WriteString(`<c:crosses val="autoZero"/><c:auto val="1"/><c:lblAlgn val="ctr"/><c:lblOffset val="100"/>`)
+	buf.WriteString(`<c:noMultiLvlLbl val="0"/>`)
+	buf.WriteString(`</c:catAx>`)
+
+	buf.WriteString(`<c:valAx>`)
+	buf.WriteString(fmt.Sprintf(`<c:axId val="%d"/>`, chartValueAxisID))
+	buf.WriteString(`<c:scaling><c:orientation val="minMax"/></c:scaling>`)
+	buf.WriteString(`<c:delete val="0"/><c:axPos val="l"/><c:majorGridlines/>`)
+	buf.WriteString(`<c:numFmt formatCode="General" sourceLinked="1"/>`)
+	buf.WriteString(`<c:majorTickMark val="out"/><c:minorTickMark val="none"/><c:tickLblPos val="nextTo"/>`)
+	buf.WriteString(fmt.Sprintf(`<c:crossAx val="%d"/>`, chartCategoryAxisID))
+	buf.WriteString(`<c:crosses val="autoZero"/><c:crossBetween val="between"/>`)
+	buf.WriteString(`</c:valAx>`)
+}
@@ -0,0 +1,169 @@
+package elements
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/didikprabowo/mbadocx/types"
+	"github.com/google/uuid"
+)
+
+// ContentTypeCustomXMLProps is the content type of a custom XML properties part
+const ContentTypeCustomXMLProps = "application/vnd.openxmlformats-officedocument.customXmlProperties+xml"
+
+const relTypeCustomXMLProps = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/customXmlProps"
+
+var _ types.Media = (*CustomXML)(nil)
+
+// CustomXML represents a custom XML data part (customXml/itemN.xml) that
+// content controls can bind to through its store item ID.
+type CustomXML struct {
+	RelationshipID string // Relationship ID in document.xml.rels
+	Index          int    // Part number, N in customXml/itemN.xml
+	StoreItemID    string // Data store item ID in braces, e.g. "{6A1C...}"
+	Data           []byte // Raw XML content
+}
+
+// NewCustomXML creates a custom XML part. An empty storeItemID generates a new one.
+func NewCustomXML(index int, storeItemID string, data []byte) (*CustomXML, error) {
+	if index < 1 {
+		return nil, fmt.Errorf("custom XML index must be positive: %d", index)
+	}
+	if err := checkWellFormed(data); err != nil {
+		return nil, fmt.Errorf("custom XML data is not well-formed: %w", err)
+	}
+
+	id := uuid.New()
+	if storeItemID != "" {
+		parsed, err := uuid.Parse(strings.Trim(storeItemID, "{}"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid store item ID %q: %w", storeItemID, err)
+		}
+		id = parsed
+	}
+
+	return &CustomXML{
+		Index:       index,
+		StoreItemID: "{" + strings.ToUpper(id.String()) + "}",
+		Data:        data,
+	}, nil
+}
+
+// checkWellFormed reports whether data is a single well-formed XML document
+func checkWellFormed(data []byte) error {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return errors.New("empty document")
+	}
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		if _, err := dec.Token(); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// RelID returns the relationship ID
+func (c *CustomXML) RelID() string {
+	return c.RelationshipID
+}
+
+// RelType returns the relationship type
+func (c *CustomXML) RelType() string {
+	return "http://schemas.openxmlformats.org/officeDocument/2006/relationships/customXml"
+}
+
+// TargetPath returns the directory of the part inside the package
+func (c *CustomXML) TargetPath() string {
+	return "customXml/"
+}
+
+// FileName returns the item file name
+func (c *CustomXML) FileName() string {
+	return fmt.Sprintf("item%d.xml", c.Index)
+}
+
+// PropsFileName returns the item properties file name
+func (c *CustomXML) PropsFileName() string {
+	return fmt.Sprintf("itemProps%d.xml", c.Index)
+}
+
+// RawContent returns the custom XML data
+func (c *CustomXML) RawContent() []byte {
+	return c.Data
+}
+
+// Parts returns the supporting package parts: the item properties holding the
+// store item ID and the item relationships pointing at them
+func (c *CustomXML) Parts() []*Part {
+	props := xml.Header +
+		`<ds:datastoreItem ds:itemID="` + c.StoreItemID + `" xmlns:ds="http://schemas.openxmlformats.org/officeDocument/2006/customXml">` +
+		`<ds:schemaRefs/>` +
+		`</ds:datastoreItem>`
+
+	rels := xml.Header +
+		`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="` + relTypeCustomXMLProps + `" Target="` + c.PropsFileName() + `"/>` +
+		`</Relationships>`
+
+	return []*Part{
+		NewPart("customXml/", c.PropsFileName(), []byte(props)),
+		NewPart("customXml/_rels/", c.FileName()+".rels", []byte(rels)),
+	}
+}
+
+// BoundText is a plain text content control whose value is bound to a node of a custom XML part
+type BoundText struct {
+	id             int64
+	StoreItemID    string // Store item ID of the custom XML part
+	XPath          string // XPath of the bound node, e.g. "/invoice/customer"
+	PrefixMappings string // Namespace prefix mappings, e.g. "xmlns:ns0='urn:invoice'"
+	Text           string // Text shown until Word refreshes the binding
+}
+
+// NewBoundText creates a new bound text content control
+func NewBoundText(storeItemID, xpath, text string) *BoundText {
+	return &BoundText{
+		id:          generateID(),
+		StoreItemID: storeItemID,
+		XPath:       xpath,
+		Text:        text,
+	}
+}
+
+// SetPrefixMappings sets the namespace prefix mappings used by the XPath
+func (b *BoundText) SetPrefixMappings(mappings string) *BoundText {
+	b.PrefixMappings = mappings
+	return b
+}
+
+// Type returns the element type
+func (b *BoundText) Type() string {
+	return "boundText"
+}
+
+// XML generates the inline content control
+func (b *BoundText) XML() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString(`<w:sdt><w:sdtPr>`)
+	fmt.Fprintf(&buf, `<w:id w:val="%d"/>`, b.id)
+	fmt.Fprintf(&buf, `<w:dataBinding w:prefixMappings="%s" w:xpath="%s" w:storeItemID="%s"/>`,
+		escapeXMLAttribute(b.PrefixMappings), escapeXMLAttribute(b.XPath), escapeXMLAttribute(b.StoreItemID))
+	buf.WriteString(`<w:text/>`)
+	buf.WriteString(`</w:sdtPr><w:sdtContent>`)
+	buf.WriteString(`<w:r><w:t xml:space="preserve">`)
+	if err := xml.EscapeText(&buf, []byte(b.Text)); err != nil {
+		return nil, fmt.Errorf("escaping bound text: %w", err)
+	}
+	buf.WriteString(`</w:t></w:r>`)
+	buf.WriteString(`</w:sdtContent></w:sdt>`)
+
+	return buf.Bytes(), nil
+}
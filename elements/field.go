@@ -0,0 +1,77 @@
+package elements
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+)
+
+// Field character types of a complex field
+const (
+	FieldCharBegin    = "begin"
+	FieldCharSeparate = "separate"
+	FieldCharEnd      = "end"
+)
+
+var (
+	_ RunChild = (*FieldChar)(nil)
+	_ RunChild = (*InstrText)(nil)
+)
+
+// FieldChar delimits a complex field: begin, instruction, separate, cached result, end
+type FieldChar struct {
+	CharType string // begin, separate, end
+	Dirty    bool   // Ask Word to update the field when the document is opened
+}
+
+// InstrText holds the instruction of a complex field (e.g. ` TOC \o "1-3" `)
+type InstrText struct {
+	Text string
+}
+
+// Type returns the element type
+func (f *FieldChar) Type() string {
+	return "fldChar"
+}
+
+// XML generates the field character
+func (f *FieldChar) XML() ([]byte, error) {
+	if f.Dirty {
+		return []byte(fmt.Sprintf(`<w:fldChar w:fldCharType="%s" w:dirty="true"/>`, f.CharType)), nil
+	}
+	return []byte(fmt.Sprintf(`<w:fldChar w:fldCharType="%s"/>`, f.CharType)), nil
+}
+
+// Type returns the element type
+func (i *InstrText) Type() string {
+	return "instrText"
+}
+
+// XML generates the field instruction
+func (i *InstrText) XML() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(`<w:instrText xml:space="preserve">`)
+	if err := xml.EscapeText(&buf, []byte(i.Text)); err != nil {
+		return nil, err
+	}
+	buf.WriteString(`</w:instrText>`)
+	return buf.Bytes(), nil
+}
+
+// newFieldRun creates a run holding a single field part
+func newFieldRun(child RunChild) *Run {
+	r := NewRun()
+	r.Children = append(r.Children, child)
+	return r
+}
+
+// AddPageNumberField adds a PAGE field showing the number of the page it is on, in the
+// format set for the section; it belongs in a header or footer
+func (p *Paragraph) AddPageNumberField() *Paragraph {
+	p.AddChildren(newFieldRun(&FieldChar{CharType: FieldCharBegin}))
+	p.AddChildren(newFieldRun(&InstrText{Text: " PAGE "}))
+	p.AddChildren(newFieldRun(&FieldChar{CharType: FieldCharSeparate}))
+	p.AddText("1") // Cached result; Word shows the actual number of each page
+	p.AddChildren(newFieldRun(&FieldChar{CharType: FieldCharEnd}))
+	return p
+}
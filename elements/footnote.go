@@ -0,0 +1,167 @@
+package elements
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/didikprabowo/mbadocx/types"
+)
+
+// ContentTypeFootnotes is the content type of the footnotes part
+const ContentTypeFootnotes = "application/vnd.openxmlformats-officedocument.wordprocessingml.footnotes+xml"
+
+var (
+	_ types.Media = (*Footnotes)(nil)
+	_ RunChild    = (*FootnoteReference)(nil)
+)
+
+// Footnote is a note shown at the bottom of the page. Word numbers footnotes
+// itself using the section's footnote settings.
+type Footnote struct {
+	ID        int        // Footnote ID referenced from the body, starting at 1
+	Paragraph *Paragraph // Footnote text; more runs can be added to it
+}
+
+// Footnotes is the word/footnotes.xml part holding every footnote of a document
+type Footnotes struct {
+	RelationshipID string // Relationship ID in document.xml.rels
+	Notes          []*Footnote
+}
+
+// NewFootnotes creates an empty footnotes part
+func NewFootnotes() *Footnotes {
+	return &Footnotes{Notes: make([]*Footnote, 0)}
+}
+
+// Add appends a footnote with the given text and returns it
+func (f *Footnotes) Add(document types.Document, text string) *Footnote {
+	p := NewParagraph(document)
+	p.SetSpacing(0, 0).SetLineSpacingSingle()
+
+	mark := p.AddRun().SetVerticalAlign("superscript").SetFontSize(10)
+	mark.Children = append(mark.Children, footnoteRefMark{})
+	p.AddText(" " + text).SetFontSize(10)
+
+	note := &Footnote{ID: len(f.Notes) + 1, Paragraph: p}
+	f.Notes = append(f.Notes, note)
+	return note
+}
+
+// UsesNumbering reports whether a footnote paragraph is a list item
+func (f *Footnotes) UsesNumbering() bool {
+	for _, note := range f.Notes {
+		if note.Paragraph.UsesNumbering() {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplaceText replaces text in every footnote and returns the number of replacements
+func (f *Footnotes) ReplaceText(old, new string) int {
+	count := 0
+	for _, note := range f.Notes {
+		count += note.Paragraph.ReplaceText(old, new)
+	}
+	return count
+}
+
+// ReplaceTextFormatted replaces text with formatted runs in every footnote
+func (f *Footnotes) ReplaceTextFormatted(old, new string, format func(*Run)) int {
+	count := 0
+	for _, note := range f.Notes {
+		count += note.Paragraph.ReplaceTextFormatted(old, new, format)
+	}
+	return count
+}
+
+// RelID returns the relationship ID
+func (f *Footnotes) RelID() string {
+	return f.RelationshipID
+}
+
+// RelType returns the relationship type
+func (f *Footnotes) RelType() string {
+	return "http://schemas.openxmlformats.org/officeDocument/2006/relationships/footnotes"
+}
+
+// TargetPath returns the directory of the part inside the package
+func (f *Footnotes) TargetPath() string {
+	return "word/"
+}
+
+// FileName returns the part file name
+func (f *Footnotes) FileName() string {
+	return "footnotes.xml"
+}
+
+// RawContent returns word/footnotes.xml, or nil when a footnote cannot be serialized; see Content for the error
+func (f *Footnotes) RawContent() []byte {
+	data, err := f.Content()
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// Content generates word/footnotes.xml, including the separator notes Word expects
+func (f *Footnotes) Content() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`)
+	buf.WriteString(`<w:footnotes xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"`)
+	buf.WriteString(` xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006" xmlns:w14="http://schemas.microsoft.com/office/word/2010/wordml" mc:Ignorable="w14">`)
+	buf.WriteString(`<w:footnote w:type="separator" w:id="-1"><w:p><w:pPr><w:spacing w:after="0" w:line="240" w:lineRule="auto"/></w:pPr><w:r><w:separator/></w:r></w:p></w:footnote>`)
+	buf.WriteString(`<w:footnote w:type="continuationSeparator" w:id="0"><w:p><w:pPr><w:spacing w:after="0" w:line="240" w:lineRule="auto"/></w:pPr><w:r><w:continuationSeparator/></w:r></w:p></w:footnote>`)
+
+	for _, note := range f.Notes {
+		fmt.Fprintf(&buf, `<w:footnote w:id="%d">`, note.ID)
+		if note.Paragraph != nil {
+			xml, err := note.Paragraph.XML()
+			if err != nil {
+				return nil, fmt.Errorf("footnote %d: %w", note.ID, err)
+			}
+			buf.Write(xml)
+		} else {
+			buf.WriteString(`<w:p/>`)
+		}
+		buf.WriteString(`</w:footnote>`)
+	}
+
+	buf.WriteString(`</w:footnotes>`)
+	return buf.Bytes(), nil
+}
+
+// FootnoteReference is the numbered mark in the body that points to a footnote
+type FootnoteReference struct {
+	ID int
+}
+
+// Type returns the element type
+func (fr *FootnoteReference) Type() string {
+	return "footnoteReference"
+}
+
+// XML generates the footnote reference
+func (fr *FootnoteReference) XML() ([]byte, error) {
+	return []byte(fmt.Sprintf(`<w:footnoteReference w:id="%d"/>`, fr.ID)), nil
+}
+
+// footnoteRefMark repeats the footnote number at the start of the footnote text
+type footnoteRefMark struct{}
+
+// Type returns the element type
+func (footnoteRefMark) Type() string {
+	return "footnoteRef"
+}
+
+// XML generates the footnote number mark
+func (footnoteRefMark) XML() ([]byte, error) {
+	return []byte(`<w:footnoteRef/>`), nil
+}
+
+// AddFootnoteReference adds a superscript run referencing the footnote
+func (p *Paragraph) AddFootnoteReference(note *Footnote) *Run {
+	r := p.AddRun().SetVerticalAlign("superscript")
+	r.Children = append(r.Children, &FootnoteReference{ID: note.ID})
+	return r
+}
@@ -0,0 +1,149 @@
+package elements
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/didikprabowo/mbadocx/relationships"
+	"github.com/didikprabowo/mbadocx/types"
+)
+
+// Header and footer part content types
+const (
+	ContentTypeHeader = "application/vnd.openxmlformats-officedocument.wordprocessingml.header+xml"
+	ContentTypeFooter = "application/vnd.openxmlformats-officedocument.wordprocessingml.footer+xml"
+)
+
+var _ types.Media = (*HeaderFooter)(nil)
+
+// HeaderFooter is a word/headerN.xml or word/footerN.xml part repeated at the
+// top or bottom of the pages of a section
+type HeaderFooter struct {
+	RelationshipID string       // Relationship ID in document.xml.rels
+	IsFooter       bool         // Footer rather than header
+	Index          int          // Part number, used for the file name
+	Paragraphs     []*Paragraph // Content; an empty part is written with one empty paragraph
+
+	document types.Document
+}
+
+// NewHeader creates an empty header part
+func NewHeader(document types.Document, index int) *HeaderFooter {
+	return &HeaderFooter{Index: index, document: document}
+}
+
+// NewFooter creates an empty footer part
+func NewFooter(document types.Document, index int) *HeaderFooter {
+	return &HeaderFooter{IsFooter: true, Index: index, document: document}
+}
+
+// AddParagraph appends a paragraph to the header or footer and returns it
+func (hf *HeaderFooter) AddParagraph() *Paragraph {
+	p := NewParagraph(hf.document)
+	hf.Paragraphs = append(hf.Paragraphs, p)
+	return p
+}
+
+// UsesNumbering reports whether a paragraph of the header or footer is a list item
+func (hf *HeaderFooter) UsesNumbering() bool {
+	for _, p := range hf.Paragraphs {
+		if p.UsesNumbering() {
+			return true
+		}
+	}
+	return false
+}
+
+// ReplaceText replaces text in every paragraph of the header or footer and returns the number of replacements
+func (hf *HeaderFooter) ReplaceText(old, new string) int {
+	count := 0
+	for _, p := range hf.Paragraphs {
+		count += p.ReplaceText(old, new)
+	}
+	return count
+}
+
+// ReplaceTextFormatted replaces text with formatted runs in every paragraph of the header or footer
+func (hf *HeaderFooter) ReplaceTextFormatted(old, new string, format func(*Run)) int {
+	count := 0
+	for _, p := range hf.Paragraphs {
+		count += p.ReplaceTextFormatted(old, new, format)
+	}
+	return count
+}
+
+// kind returns "header" or "footer"
+func (hf *HeaderFooter) kind() string {
+	if hf.IsFooter {
+		return "footer"
+	}
+	return "header"
+}
+
+// RelID returns the relationship ID
+func (hf *HeaderFooter) RelID() string {
+	return hf.RelationshipID
+}
+
+// RelType returns the relationship type
+func (hf *HeaderFooter) RelType() string {
+	if hf.IsFooter {
+		return relationships.TypeFooter
+	}
+	return relationships.TypeHeader
+}
+
+// ContentType returns the content type of the part
+func (hf *HeaderFooter) ContentType() string {
+	if hf.IsFooter {
+		return ContentTypeFooter
+	}
+	return ContentTypeHeader
+}
+
+// TargetPath returns the directory of the part inside the package
+func (hf *HeaderFooter) TargetPath() string {
+	return "word/"
+}
+
+// FileName returns the part file name
+func (hf *HeaderFooter) FileName() string {
+	return fmt.Sprintf("%s%d.xml", hf.kind(), hf.Index)
+}
+
+// RawContent returns the part, or nil when a paragraph cannot be serialized; see Content for the error
+func (hf *HeaderFooter) RawContent() []byte {
+	data, err := hf.Content()
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// Content generates the <w:hdr> or <w:ftr> part
+func (hf *HeaderFooter) Content() ([]byte, error) {
+	root := "w:hdr"
+	if hf.IsFooter {
+		root = "w:ftr"
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`)
+	fmt.Fprintf(&buf, `<%s xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"`, root)
+	buf.WriteString(` xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006" xmlns:w14="http://schemas.microsoft.com/office/word/2010/wordml" mc:Ignorable="w14">`)
+
+	// A header or footer must hold at least one paragraph
+	if len(hf.Paragraphs) == 0 {
+		buf.WriteString(`<w:p/>`)
+	}
+	for i, p := range hf.Paragraphs {
+		xml, err := p.XML()
+		if err != nil {
+			return nil, fmt.Errorf("%s %d paragraph %d: %w", hf.kind(), hf.Index, i+1, err)
+		}
+		buf.Write(xml)
+	}
+
+	fmt.Fprintf(&buf, `</%s>`, root)
+	return buf.Bytes(), nil
+}
@@ -23,6 +23,9 @@ type Hyperlink struct {
 	TargetFrame string                    // Target frame
 	History     bool                      // Add to history
 	ScreenTip   string                    // Extended tooltip
+
+	paragraph *Paragraph // Parent paragraph, passed on to the runs for inherited formatting
+	err       error      // First invalid setter argument, reported by Err and Validate
 }
 
 // HyperlinkType constants
@@ -143,6 +146,7 @@ func (h *Hyperlink) SetDocLocation(location string) *Hyperlink {
 // AddRun adds a run to the hyperlink
 func (h *Hyperlink) AddRun() *Run {
 	r := NewRun()
+	r.paragraph = h.paragraph
 	// Apply hyperlink properties to the run
 	if h.Properties != nil {
 		r.Properties = h.Properties.Clone()
@@ -200,8 +204,16 @@ func (h *Hyperlink) RemoveUnderline() *Hyperlink {
 	return h
 }
 
-// SetColor sets the hyperlink color
+// SetColor sets the hyperlink color. An invalid color leaves the color
+// unchanged and is reported by Err.
 func (h *Hyperlink) SetColor(color string) *Hyperlink {
+	color, err := properties.NormalizeColor(color)
+	if err != nil {
+		if h.err == nil {
+			h.err = err
+		}
+		return h
+	}
 	if h.Properties == nil {
 		h.Properties = properties.NewRunProperties()
 	}
@@ -216,9 +228,16 @@ func (h *Hyperlink) SetColor(color string) *Hyperlink {
 	return h
 }
 
+// Err returns the first error recorded by a setter, such as an invalid color.
+// The rejected setting is not applied, so the hyperlink can still be saved.
+func (h *Hyperlink) Err() error {
+	return h.err
+}
+
 // Clone creates a deep copy of the hyperlink
 func (h *Hyperlink) Clone() *Hyperlink {
 	clone := &Hyperlink{
+		err:         h.err,
 		ID:          h.ID,
 		URL:         h.URL,
 		Tooltip:     h.Tooltip,
@@ -245,8 +264,22 @@ func (h *Hyperlink) Clone() *Hyperlink {
 	return clone
 }
 
+// setParagraph links the hyperlink and its runs to the paragraph holding them
+func (h *Hyperlink) setParagraph(p *Paragraph) {
+	h.paragraph = p
+	for _, child := range h.Children {
+		if r, ok := child.(*Run); ok {
+			r.paragraph = p
+		}
+	}
+}
+
 // Validate validates the hyperlink
 func (h *Hyperlink) Validate() error {
+	if h.err != nil {
+		return h.err
+	}
+
 	if h.Typ == HyperlinkTypeExternal && h.URL == "" {
 		return fmt.Errorf("external hyperlink must have a URL")
 	}
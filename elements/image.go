@@ -2,21 +2,29 @@
 package elements
 
 import (
+	"bufio"
 	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"image"
-	_ "image/gif"
+	"image/draw"
+	"image/gif"
 	_ "image/jpeg"
-	_ "image/png"
+	"image/png"
 	"io"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 
 	"github.com/didikprabowo/mbadocx/properties"
 	"github.com/didikprabowo/mbadocx/relationships"
 	"github.com/didikprabowo/mbadocx/types"
+	"github.com/didikprabowo/mbadocx/units"
 )
 
 var (
@@ -32,10 +40,18 @@ type Image struct {
 	Height         int64 // Height in EMUs
 	Name           string
 	Description    string
+	fileName       string // Media part name in word/media/
+	sourcePath     string // File streamed at save time when Data is nil (NewImageStream)
+	linkURL        string // Remote image referenced with r:link instead of embedded (NewLinkedImage)
 	Data           []byte
 	ContentType    string
 	Extension      string
 	props          properties.ImageProperties
+	pixelWidth     int     // Native width in pixels
+	pixelHeight    int     // Native height in pixels
+	dpiX           float64 // Horizontal resolution used for pixel sizing (0 = 96 DPI)
+	dpiY           float64 // Vertical resolution used for pixel sizing (0 = 96 DPI)
+	err            error   // First invalid setter argument, reported by Err
 }
 
 const (
@@ -57,106 +73,268 @@ const (
 	ContentTypeSVG  = "image/svg+xml"
 )
 
+// Image errors, usable with errors.Is
+var (
+	ErrUnsupportedImageFormat = errors.New("unsupported image format")
+	ErrInvalidImage           = errors.New("invalid image data")
+	ErrEmptyImage             = errors.New("empty image data")
+)
+
+// ImageError reports image data that could not be decoded. errors.Is matches
+// its Kind, such as ErrInvalidImage, and errors.Unwrap returns the decoder error.
+type ImageError struct {
+	Msg   string // What failed, e.g. failed to decode GIF "logo"
+	Kind  error  // One of the image error sentinels
+	Cause error  // Underlying decoder error
+}
+
+// Error returns the message followed by the kind and the cause
+func (e *ImageError) Error() string {
+	return fmt.Sprintf("%s: %v: %v", e.Msg, e.Kind, e.Cause)
+}
+
+// Unwrap returns the underlying decoder error
+func (e *ImageError) Unwrap() error {
+	return e.Cause
+}
+
+// Is reports whether target is the kind of the error
+func (e *ImageError) Is(target error) bool {
+	return target == e.Kind
+}
+
 // NewImage creates a new image from file path
 func NewImage(document types.Document, filePath string) (*Image, error) {
 	// Read file
 	data, err := os.ReadFile(filePath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read image file: %w", err)
+		return nil, fmt.Errorf("failed to read image file %q: %w", filePath, err)
 	}
-
-	// Get file extension
-	ext := strings.ToLower(filepath.Ext(filePath))
-	if ext == "" {
-		return nil, fmt.Errorf("image file must have an extension")
-	}
-	ext = strings.TrimPrefix(ext, ".")
-
-	// Determine content type
-	contentType := getContentType(ext)
-	if contentType == "" {
-		return nil, fmt.Errorf("unsupported image format: %s", ext)
+	if len(data) == 0 {
+		return nil, fmt.Errorf("image file %q: %w", filePath, ErrEmptyImage)
 	}
 
-	// Get image dimensions
-	width, height, err := getImageDimensions(data)
+	// The format comes from the data; the file extension is only reported on errors
+	claimed := getContentType(strings.TrimPrefix(strings.ToLower(filepath.Ext(filePath)), "."))
+	width, height, format, err := getImageDimensions(data)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get image dimensions: %w", err)
+		return nil, dimensionsError(filePath, claimed, data, err)
+	}
+	ext := formatExtension(format)
+	if ext == "" {
+		return nil, fmt.Errorf("image file %q has format %q: %w", filePath, format, ErrUnsupportedImageFormat)
 	}
+	contentType := getContentType(ext)
 
 	// Create image with default properties
 	img := &Image{
 		document:    document,
 		Name:        filepath.Base(filePath),
 		Description: fmt.Sprintf("Image: %s", filepath.Base(filePath)),
+		fileName:    filepath.Base(filePath),
 		Data:        data,
 		ContentType: contentType,
 		Extension:   ext,
 		Width:       int64(width) * EmusPerPixel,
 		Height:      int64(height) * EmusPerPixel,
 		props:       *properties.NewImageProperties(),
+		pixelWidth:  width,
+		pixelHeight: height,
 	}
 
 	// Register with relationships
 	if document != nil {
-		rel := document.Relationships().AddImage(img.Name)
+		img.register(document)
+	}
+
+	return img, nil
+}
+
+// NewImageStream creates an image that is read from filePath when the document is saved.
+// Only the image header is decoded up front, so large files are never held in memory;
+// the file must remain in place until the document has been saved.
+func NewImageStream(document types.Document, filePath string) (*Image, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open image file %q: %w", filePath, err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat image file %q: %w", filePath, err)
+	}
+	if info.Size() == 0 {
+		return nil, fmt.Errorf("image file %q: %w", filePath, ErrEmptyImage)
+	}
+
+	// The header is enough to detect the format
+	header := make([]byte, 512)
+	n, err := io.ReadFull(file, header)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, fmt.Errorf("failed to read image file %q: %w", filePath, err)
+	}
+	header = header[:n]
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to read image file %q: %w", filePath, err)
+	}
+	// The format comes from the data; the file extension is only reported on errors
+	config, format, err := image.DecodeConfig(bufio.NewReader(file))
+	if err != nil {
+		claimed := getContentType(strings.TrimPrefix(strings.ToLower(filepath.Ext(filePath)), "."))
+		return nil, dimensionsError(filePath, claimed, header, err)
+	}
+	ext := formatExtension(format)
+	if ext == "" {
+		return nil, fmt.Errorf("image file %q has format %q: %w", filePath, format, ErrUnsupportedImageFormat)
+	}
+	contentType := getContentType(ext)
+
+	// Hash the content in a single streaming pass for deduplication
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to read image file %q: %w", filePath, err)
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return nil, fmt.Errorf("failed to read image file %q: %w", filePath, err)
+	}
+	var sum [sha256.Size]byte
+	copy(sum[:], hash.Sum(nil))
+
+	img := &Image{
+		document:    document,
+		Name:        filepath.Base(filePath),
+		Description: fmt.Sprintf("Image: %s", filepath.Base(filePath)),
+		fileName:    filepath.Base(filePath),
+		sourcePath:  filePath,
+		ContentType: contentType,
+		Extension:   ext,
+		Width:       int64(config.Width) * EmusPerPixel,
+		Height:      int64(config.Height) * EmusPerPixel,
+		props:       *properties.NewImageProperties(),
+		pixelWidth:  config.Width,
+		pixelHeight: config.Height,
+	}
+
+	if document != nil {
+		rel := document.Relationships().AddImageHash(sum, ext)
 		img.RelationshipID = rel.ID
+		img.fileName = path.Base(rel.Target)
 	}
 
 	return img, nil
 }
 
-// NewImageFromBytes creates a new image from byte data
+// NewImageFromBytes creates a new image from byte data. The format is detected
+// from the data; contentType is only reported when the data cannot be decoded.
 func NewImageFromBytes(document types.Document, data []byte, name string, contentType string) (*Image, error) {
-	// Get image dimensions
-	width, height, err := getImageDimensions(data)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get image dimensions: %w", err)
+	if len(data) == 0 {
+		return nil, fmt.Errorf("image %q: %w", name, ErrEmptyImage)
 	}
 
-	// Determine extension from content type
-	ext := getExtensionFromContentType(contentType)
+	// The format comes from the data; contentType is only reported on errors
+	width, height, format, err := getImageDimensions(data)
+	if err != nil {
+		return nil, dimensionsError(name, contentType, data, err)
+	}
+	ext := formatExtension(format)
 	if ext == "" {
-		return nil, fmt.Errorf("unsupported content type: %s", contentType)
+		return nil, fmt.Errorf("image %q has format %q: %w", name, format, ErrUnsupportedImageFormat)
 	}
+	contentType = getContentType(ext)
 
 	img := &Image{
 		document:    document,
 		Name:        name,
 		Description: fmt.Sprintf("Image: %s", name),
+		fileName:    name + "." + ext,
 		Data:        data,
 		ContentType: contentType,
 		Extension:   ext,
 		Width:       int64(width) * EmusPerPixel,
 		Height:      int64(height) * EmusPerPixel,
 		props:       *properties.NewImageProperties(),
+		pixelWidth:  width,
+		pixelHeight: height,
 	}
 
 	// Register with relationships
 	if document != nil {
-		rel := document.Relationships().AddImage(img.Name + "." + img.Extension)
+		img.register(document)
+	}
+
+	return img, nil
+}
+
+// NewLinkedImage creates an image that references a remote URL instead of embedding its bytes.
+// The image is not downloaded, so the display size must be given.
+func NewLinkedImage(document types.Document, imageURL string, widthInches, heightInches float64) (*Image, error) {
+	u, err := url.Parse(imageURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid image URL %q", imageURL)
+	}
+	if widthInches <= 0 || heightInches <= 0 {
+		return nil, fmt.Errorf("linked image size must be positive: %vx%v inches", widthInches, heightInches)
+	}
+
+	name := path.Base(u.Path)
+	if name == "." || name == "/" {
+		name = u.Host
+	}
+	ext := strings.ToLower(strings.TrimPrefix(path.Ext(name), "."))
+
+	img := &Image{
+		document:    document,
+		Name:        name,
+		Description: fmt.Sprintf("Image: %s", name),
+		linkURL:     imageURL,
+		ContentType: getContentType(ext),
+		Extension:   ext,
+		Width:       int64(widthInches * float64(EmusPerInch)),
+		Height:      int64(heightInches * float64(EmusPerInch)),
+		props:       *properties.NewImageProperties(),
+	}
+
+	if document != nil {
+		rel := document.Relationships().AddExternalImage(imageURL)
 		img.RelationshipID = rel.ID
 	}
 
 	return img, nil
 }
 
+// IsLinked reports whether the image references a remote URL rather than embedded data
+func (img *Image) IsLinked() bool {
+	return img.linkURL != ""
+}
+
 // NewImageFromReader creates a new image from an io.Reader
 func NewImageFromReader(document types.Document, reader io.Reader, name string) (*Image, error) {
 	data, err := io.ReadAll(reader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read image data: %w", err)
 	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("image %q: %w", name, ErrEmptyImage)
+	}
 
 	// Detect content type from data
 	contentType := detectContentType(data)
 	if contentType == "" {
-		return nil, fmt.Errorf("unable to detect image format")
+		return nil, fmt.Errorf("unable to detect format of image %q: %w", name, ErrUnsupportedImageFormat)
 	}
 
 	return NewImageFromBytes(document, data, name, contentType)
 }
 
+// register adds the image relationship; images with identical content share one media part
+func (img *Image) register(document types.Document) {
+	rel := document.Relationships().AddImageData(img.Data, img.Extension)
+	img.RelationshipID = rel.ID
+	img.fileName = path.Base(rel.Target)
+}
+
 // Type returns the element type
 func (img *Image) Type() string {
 	return "image"
@@ -177,14 +355,44 @@ func (img *Image) TargetPath() string {
 	return "word/media/"
 }
 
-// FileName returns the file name
+// FileName returns the media part name; together with TargetPath it matches the relationship target media/<name>
 func (img *Image) FileName() string {
-	return img.Name
+	return img.fileName
 }
 
-// RawContent returns the raw image data
+// MediaType returns the MIME type declared for the image extension in [Content_Types].xml
+func (img *Image) MediaType() string {
+	return img.ContentType
+}
+
+// RawContent returns the raw image data, or nil when a streamed image cannot be
+// read from disk; see ReadData for the error. The writer streams images with Open.
 func (img *Image) RawContent() []byte {
-	return img.Data
+	data, err := img.ReadData()
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// ReadData returns the raw image data, reading it from disk for streamed images
+func (img *Image) ReadData() ([]byte, error) {
+	if img.Data == nil && img.sourcePath != "" {
+		data, err := os.ReadFile(img.sourcePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read image %q: %w", img.sourcePath, err)
+		}
+		return data, nil
+	}
+	return img.Data, nil
+}
+
+// Open returns a reader over the image data; streamed images are read from disk
+func (img *Image) Open() (io.ReadCloser, error) {
+	if img.Data == nil && img.sourcePath != "" {
+		return os.Open(img.sourcePath)
+	}
+	return io.NopCloser(bytes.NewReader(img.Data)), nil
 }
 
 // SetSize sets the image size in inches
@@ -201,13 +409,40 @@ func (img *Image) SetSizeInCm(widthCm, heightCm float64) *Image {
 	return img
 }
 
-// SetSizeInPixels sets the image size in pixels (at 96 DPI)
+// SetSizeLength sets the image size from typed lengths (e.g., units.Cm(5))
+func (img *Image) SetSizeLength(width, height units.Length) *Image {
+	img.Width = int64(width.EMU())
+	img.Height = int64(height.EMU())
+	return img
+}
+
+// SetSizeInPixels sets the image size in pixels (at 96 DPI, or the image DPI after UseImageDPI)
 func (img *Image) SetSizeInPixels(widthPx, heightPx int) *Image {
-	img.Width = int64(widthPx) * EmusPerPixel
-	img.Height = int64(heightPx) * EmusPerPixel
+	img.Width = int64(float64(widthPx) * img.emusPerPixel(img.dpiX))
+	img.Height = int64(float64(heightPx) * img.emusPerPixel(img.dpiY))
 	return img
 }
 
+// UseImageDPI sizes the image from the resolution stored in the file (PNG pHYs, JPEG JFIF)
+// so that it keeps its physical size; images without DPI information keep 96 DPI
+func (img *Image) UseImageDPI() *Image {
+	dpiX, dpiY, ok := readImageDPI(img.RawContent())
+	if !ok {
+		return img
+	}
+
+	img.dpiX, img.dpiY = dpiX, dpiY
+	return img.SetSizeInPixels(img.pixelWidth, img.pixelHeight)
+}
+
+// emusPerPixel returns the EMUs per pixel at the given resolution
+func (img *Image) emusPerPixel(dpi float64) float64 {
+	if dpi <= 0 {
+		dpi = defaultImageDPI
+	}
+	return float64(EmusPerInch) / dpi
+}
+
 // ScaleToWidth scales the image to a specific width while maintaining aspect ratio
 func (img *Image) ScaleToWidth(widthInches float64) *Image {
 	targetWidth := int64(widthInches * float64(EmusPerInch))
@@ -289,6 +524,11 @@ func (img *Image) SetAlignment(align properties.ImageAlignment) *Image {
 	return img
 }
 
+// Alignment returns the horizontal alignment set with SetAlignment
+func (img *Image) Alignment() properties.ImageAlignment {
+	return img.props.Alignment
+}
+
 // SetWrapStyle sets how text wraps around the image
 func (img *Image) SetWrapStyle(wrap properties.WrapStyle) *Image {
 	img.props.WrapType = wrap
@@ -298,14 +538,31 @@ func (img *Image) SetWrapStyle(wrap properties.WrapStyle) *Image {
 	return img
 }
 
-// SetBorder adds a border to the image
+// SetBorder adds a border to the image. An invalid color leaves the border
+// unchanged and is reported by Err.
 func (img *Image) SetBorder(width int, color string) *Image {
+	normalized, err := properties.NormalizeColor(color)
+	if err == nil && normalized == "auto" {
+		err = fmt.Errorf("image border needs an explicit color: %q", color)
+	}
+	if err != nil {
+		if img.err == nil {
+			img.err = err
+		}
+		return img
+	}
 	img.props.BorderWidth = width
-	img.props.BorderColor = strings.TrimPrefix(color, "#")
+	img.props.BorderColor = normalized
 	img.props.BorderStyle = "solid"
 	return img
 }
 
+// Err returns the first error recorded by a setter, such as an invalid border color.
+// The rejected setting is not applied, so the image can still be saved.
+func (img *Image) Err() error {
+	return img.err
+}
+
 // SetShadow adds a shadow effect to the image
 func (img *Image) SetShadow(enabled bool) *Image {
 	img.props.Shadow = enabled
@@ -334,6 +591,30 @@ func (img *Image) SetTransparency(transparency float64) *Image {
 	return img
 }
 
+// SetLockAspectRatio sets whether Word keeps the aspect ratio when the image is resized
+func (img *Image) SetLockAspectRatio(lock bool) *Image {
+	img.props.LockAspectRatio = lock
+	return img
+}
+
+// SetZOrder sets the stacking order of a floating image (0 to properties.MaxZOrder);
+// higher values are drawn above lower ones
+func (img *Image) SetZOrder(order int) *Image {
+	if order < 0 {
+		order = 0
+	} else if order > properties.MaxZOrder {
+		order = properties.MaxZOrder
+	}
+	img.props.ZOrder = order
+	return img
+}
+
+// SetAllowOverlap sets whether a floating image may overlap other floating objects
+func (img *Image) SetAllowOverlap(allow bool) *Image {
+	img.props.AllowOverlap = allow
+	return img
+}
+
 // SetAltText sets the alternative text for accessibility
 func (img *Image) SetAltText(text string) *Image {
 	img.props.AltText = text
@@ -351,6 +632,44 @@ func (img *Image) SetFloating(hAnchor properties.HorizontalAnchor, vAnchor prope
 	return img
 }
 
+// SetFloatingAlignment positions a floating image by named alignment relative to its anchors
+// h: "left", "center", "right", "inside", "outside"; v: "top", "center", "bottom", "inside", "outside".
+// An empty value keeps the offset for that direction. Anchors default to the margin.
+func (img *Image) SetFloatingAlignment(h, v string) *Image {
+	img.props.Inline = false
+	img.props.HorizontalAlign = h
+	img.props.VerticalAlign = v
+	if img.props.HorizontalPosition == "" {
+		img.props.HorizontalPosition = properties.HorizontalAnchorMargin
+	}
+	if img.props.VerticalPosition == "" {
+		img.props.VerticalPosition = properties.VerticalAnchorMargin
+	}
+	if img.props.WrapType == properties.WrapInline {
+		img.props.WrapType = properties.WrapSquare
+	}
+	return img
+}
+
+// SetTextDistance sets the gap between a wrapped image and the surrounding text in EMUs
+func (img *Image) SetTextDistance(top, bottom, left, right int64) *Image {
+	img.props.DistanceTop = top
+	img.props.DistanceBottom = bottom
+	img.props.DistanceLeft = left
+	img.props.DistanceRight = right
+	return img
+}
+
+// SetWrapPolygon sets the outline text wraps to for tight and through wrapping
+// Points use the 0-21600 coordinate space of the image (see properties.WrapPolygonMax)
+func (img *Image) SetWrapPolygon(points []properties.Point) *Image {
+	img.props.WrapPolygon = points
+	if img.props.WrapType != properties.WrapThrough {
+		img.SetWrapStyle(properties.WrapTight)
+	}
+	return img
+}
+
 // SetOffset sets the offset for floating images in EMUs
 func (img *Image) SetOffset(horizontal, vertical int64) *Image {
 	img.props.HorizontalOffset = horizontal
@@ -378,7 +697,8 @@ func (img *Image) XML() ([]byte, error) {
 	docPrID := generateID()
 	picID := generateID()
 
-	// Start drawing
+	// Drawings are run content; a bare <w:drawing> in <w:p> is rejected by Word
+	buf.WriteString(`<w:r>`)
 	buf.WriteString(`<w:drawing>`)
 
 	if img.props.Inline {
@@ -393,9 +713,9 @@ func (img *Image) XML() ([]byte, error) {
 
 		// Document properties
 		buf.WriteString(fmt.Sprintf(`<wp:docPr id="%d" name="%s" descr="%s"`,
-			docPrID, img.Name, img.props.AltText))
+			docPrID, escapeXMLAttribute(img.Name), escapeXMLAttribute(img.props.AltText)))
 		if img.props.AltText != "" {
-			buf.WriteString(fmt.Sprintf(` title="%s"`, img.props.AltText))
+			buf.WriteString(fmt.Sprintf(` title="%s"`, escapeXMLAttribute(img.props.AltText)))
 		}
 		buf.WriteString(`/>`)
 
@@ -422,9 +742,9 @@ func (img *Image) XML() ([]byte, error) {
 
 		// Document properties
 		buf.WriteString(fmt.Sprintf(`<wp:docPr id="%d" name="%s" descr="%s"`,
-			docPrID, img.Name, img.props.AltText))
+			docPrID, escapeXMLAttribute(img.Name), escapeXMLAttribute(img.props.AltText)))
 		if img.props.AltText != "" {
-			buf.WriteString(fmt.Sprintf(` title="%s"`, img.props.AltText))
+			buf.WriteString(fmt.Sprintf(` title="%s"`, escapeXMLAttribute(img.props.AltText)))
 		}
 		buf.WriteString(`/>`)
 
@@ -445,9 +765,9 @@ func (img *Image) XML() ([]byte, error) {
 	// Non-visual picture properties
 	buf.WriteString(`<pic:nvPicPr>`)
 	buf.WriteString(fmt.Sprintf(`<pic:cNvPr id="%d" name="%s" descr="%s"`,
-		picID, img.Name, img.props.AltText))
+		picID, escapeXMLAttribute(img.Name), escapeXMLAttribute(img.props.AltText)))
 	if img.props.AltText != "" {
-		buf.WriteString(fmt.Sprintf(` title="%s"`, img.props.AltText))
+		buf.WriteString(fmt.Sprintf(` title="%s"`, escapeXMLAttribute(img.props.AltText)))
 	}
 	buf.WriteString(`/>`)
 	buf.WriteString(`<pic:cNvPicPr>`)
@@ -457,7 +777,11 @@ func (img *Image) XML() ([]byte, error) {
 
 	// Blip (image reference) with effects
 	buf.WriteString(`<pic:blipFill>`)
-	buf.WriteString(fmt.Sprintf(`<a:blip r:embed="%s">`, img.RelationshipID))
+	if img.IsLinked() {
+		buf.WriteString(fmt.Sprintf(`<a:blip r:link="%s">`, img.RelationshipID))
+	} else {
+		buf.WriteString(fmt.Sprintf(`<a:blip r:embed="%s">`, img.RelationshipID))
+	}
 
 	// Add image adjustments if any
 	adjustmentsXML := img.props.GenerateImageAdjustmentsXML()
@@ -509,18 +833,23 @@ func (img *Image) XML() ([]byte, error) {
 	}
 
 	buf.WriteString(`</w:drawing>`)
+	buf.WriteString(`</w:r>`)
 
 	return buf.Bytes(), nil
 }
 
 // GetBase64Data returns the image data as base64 encoded string
 func (img *Image) GetBase64Data() string {
-	return base64.StdEncoding.EncodeToString(img.Data)
+	return base64.StdEncoding.EncodeToString(img.RawContent())
 }
 
 // SaveToFile saves the image data to a file
 func (img *Image) SaveToFile(path string) error {
-	return os.WriteFile(path, img.Data, 0644)
+	data, err := img.ReadData()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
 }
 
 // GetDimensionsInInches returns the image dimensions in inches
@@ -552,10 +881,44 @@ func (img *Image) GetAspectRatio() float64 {
 	return float64(img.Width) / float64(img.Height)
 }
 
+// FlattenGIF returns the first frame of a (possibly animated) GIF as PNG data, drawn on the full logical screen
+func (img *Image) FlattenGIF() ([]byte, error) {
+	if img.ContentType != ContentTypeGIF {
+		return nil, fmt.Errorf("image %q has content type %q, not %s: %w", img.Name, img.ContentType, ContentTypeGIF, ErrUnsupportedImageFormat)
+	}
+
+	data, err := img.ReadData()
+	if err != nil {
+		return nil, err
+	}
+
+	anim, err := gif.DecodeAll(bytes.NewReader(data))
+	if err != nil {
+		return nil, &ImageError{Msg: fmt.Sprintf("failed to decode GIF %q", img.Name), Kind: ErrInvalidImage, Cause: err}
+	}
+	if len(anim.Image) == 0 {
+		return nil, fmt.Errorf("GIF %q has no frames: %w", img.Name, ErrInvalidImage)
+	}
+
+	// Frames may be smaller than the logical screen and offset within it
+	canvas := image.NewRGBA(image.Rect(0, 0, anim.Config.Width, anim.Config.Height))
+	frame := anim.Image[0]
+	draw.Draw(canvas, frame.Bounds(), frame, frame.Bounds().Min, draw.Over)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, canvas); err != nil {
+		return nil, fmt.Errorf("failed to encode first frame of %q as PNG: %w", img.Name, err)
+	}
+	return buf.Bytes(), nil
+}
+
 // Clone creates a deep copy of the image
 func (img *Image) Clone() *Image {
-	dataCopy := make([]byte, len(img.Data))
-	copy(dataCopy, img.Data)
+	var dataCopy []byte
+	if img.Data != nil {
+		dataCopy = make([]byte, len(img.Data))
+		copy(dataCopy, img.Data)
+	}
 
 	return &Image{
 		document:       img.document,
@@ -564,10 +927,18 @@ func (img *Image) Clone() *Image {
 		Height:         img.Height,
 		Name:           img.Name,
 		Description:    img.Description,
+		fileName:       img.fileName,
+		sourcePath:     img.sourcePath,
+		linkURL:        img.linkURL,
 		Data:           dataCopy,
 		ContentType:    img.ContentType,
 		Extension:      img.Extension,
 		props:          img.props,
+		pixelWidth:     img.pixelWidth,
+		pixelHeight:    img.pixelHeight,
+		dpiX:           img.dpiX,
+		dpiY:           img.dpiY,
+		err:            img.err,
 	}
 }
 
@@ -633,13 +1004,40 @@ func detectContentType(data []byte) string {
 	return ""
 }
 
-func getImageDimensions(data []byte) (width, height int, err error) {
+// dimensionsError describes a decode failure with the claimed and detected content types
+func dimensionsError(name, contentType string, data []byte, err error) error {
+	detected := detectContentType(data)
+	if detected == "" {
+		detected = "unknown"
+	}
+	if contentType == "" {
+		contentType = "unknown"
+	}
+	if err == io.ErrUnexpectedEOF {
+		err = fmt.Errorf("truncated data (%d bytes): %w", len(data), err)
+	}
+	return &ImageError{
+		Msg:   fmt.Sprintf("failed to read dimensions of %q (claimed %s, detected %s)", name, contentType, detected),
+		Kind:  ErrInvalidImage,
+		Cause: err,
+	}
+}
+
+// getImageDimensions returns the pixel size and the format name detected from the data
+// ("jpeg", "png", "gif"); for GIFs the size is the logical screen size, not the first frame
+func getImageDimensions(data []byte) (width, height int, format string, err error) {
 	reader := bytes.NewReader(data)
-	config, _, err := image.DecodeConfig(reader)
+	config, format, err := image.DecodeConfig(reader)
 	if err != nil {
-		return 0, 0, err
+		return 0, 0, "", err
 	}
-	return config.Width, config.Height, nil
+	return config.Width, config.Height, format, nil
+}
+
+// formatExtension returns the media file extension for a format detected by
+// getImageDimensions, or "" when the format cannot be stored
+func formatExtension(format string) string {
+	return getExtensionFromContentType(getContentType(format))
 }
 
 func clamp(value, min, max float64) float64 {
@@ -659,10 +1057,9 @@ func boolToString(b bool) string {
 	return "0"
 }
 
-var idCounter int64 = 1
+var idCounter int64
 
+// generateID returns a unique drawing ID; safe for concurrent use
 func generateID() int64 {
-	id := idCounter
-	idCounter++
-	return id
+	return atomic.AddInt64(&idCounter, 1)
 }
@@ -0,0 +1,95 @@
+// elements/image_dpi.go
+package elements
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// Default screen resolution assumed when an image carries no DPI information
+const defaultImageDPI = 96.0
+
+// readImageDPI returns the horizontal and vertical resolution stored in the
+// image metadata (PNG pHYs chunk or JPEG JFIF header). ok is false when the
+// image does not declare a physical resolution.
+func readImageDPI(data []byte) (dpiX, dpiY float64, ok bool) {
+	switch {
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return readPNGDPI(data)
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8}):
+		return readJPEGDPI(data)
+	}
+	return 0, 0, false
+}
+
+// readPNGDPI reads the pHYs chunk of a PNG image
+func readPNGDPI(data []byte) (float64, float64, bool) {
+	pos := 8 // Skip signature
+	for pos+8 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		chunkType := string(data[pos+4 : pos+8])
+		body := pos + 8
+
+		if body+length > len(data) {
+			break
+		}
+
+		switch chunkType {
+		case "pHYs":
+			if length < 9 || data[body+8] != 1 { // Unit 1 = pixels per meter
+				return 0, 0, false
+			}
+			ppmX := float64(binary.BigEndian.Uint32(data[body:]))
+			ppmY := float64(binary.BigEndian.Uint32(data[body+4:]))
+			if ppmX == 0 || ppmY == 0 {
+				return 0, 0, false
+			}
+			return ppmX * 0.0254, ppmY * 0.0254, true
+		case "IDAT", "IEND":
+			// pHYs must appear before the image data
+			return 0, 0, false
+		}
+
+		pos = body + length + 4 // Skip chunk data and CRC
+	}
+	return 0, 0, false
+}
+
+// readJPEGDPI reads the density fields of the JFIF APP0 segment
+func readJPEGDPI(data []byte) (float64, float64, bool) {
+	pos := 2 // Skip SOI
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return 0, 0, false
+		}
+
+		marker := data[pos+1]
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		segment := pos + 4
+
+		// Start of scan: no more metadata segments
+		if marker == 0xDA || segment+length-2 > len(data) {
+			return 0, 0, false
+		}
+
+		if marker == 0xE0 && length >= 16 && bytes.HasPrefix(data[segment:], []byte("JFIF\x00")) {
+			unit := data[segment+7]
+			x := float64(binary.BigEndian.Uint16(data[segment+8:]))
+			y := float64(binary.BigEndian.Uint16(data[segment+10:]))
+			if x == 0 || y == 0 {
+				return 0, 0, false
+			}
+
+			switch unit {
+			case 1: // Dots per inch
+				return x, y, true
+			case 2: // Dots per centimeter
+				return x * 2.54, y * 2.54, true
+			}
+			return 0, 0, false
+		}
+
+		pos = segment + length - 2
+	}
+	return 0, 0, false
+}
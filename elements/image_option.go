@@ -0,0 +1,46 @@
+package elements
+
+import "github.com/didikprabowo/mbadocx/properties"
+
+// ImageOption configures an image; options are applied in order by Document.AddImageWith
+type ImageOption func(*Image)
+
+// WithSizeInches sets the displayed size in inches
+func WithSizeInches(width, height float64) ImageOption {
+	return func(img *Image) { img.SetSize(width, height) }
+}
+
+// WithSizeCm sets the displayed size in centimeters
+func WithSizeCm(width, height float64) ImageOption {
+	return func(img *Image) { img.SetSizeInCm(width, height) }
+}
+
+// WithWidthInches scales the image to a width in inches, keeping the aspect ratio
+func WithWidthInches(width float64) ImageOption {
+	return func(img *Image) { img.ScaleToWidth(width) }
+}
+
+// WithMaxSizeInches shrinks the image to fit a box in inches, keeping the aspect ratio
+func WithMaxSizeInches(maxWidth, maxHeight float64) ImageOption {
+	return func(img *Image) { img.FitToBox(maxWidth, maxHeight) }
+}
+
+// WithAlignment sets the horizontal alignment of the image in its paragraph
+func WithAlignment(align properties.ImageAlignment) ImageOption {
+	return func(img *Image) { img.SetAlignment(align) }
+}
+
+// WithBorder draws a border of the given width (in points) and hex color
+func WithBorder(width int, color string) ImageOption {
+	return func(img *Image) { img.SetBorder(width, color) }
+}
+
+// WithAltText sets the alternative text read by screen readers
+func WithAltText(text string) ImageOption {
+	return func(img *Image) { img.SetAltText(text) }
+}
+
+// WithWrapStyle sets how text wraps around the image
+func WithWrapStyle(wrap properties.WrapStyle) ImageOption {
+	return func(img *Image) { img.SetWrapStyle(wrap) }
+}
@@ -0,0 +1,145 @@
+package elements
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/didikprabowo/mbadocx/relationships"
+	"github.com/didikprabowo/mbadocx/types"
+)
+
+// Embedded object content types
+const (
+	ContentTypeOLEObject = "application/vnd.openxmlformats-officedocument.oleObject"
+	ContentTypeDOCX      = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	ContentTypePPTX      = "application/vnd.openxmlformats-officedocument.presentationml.presentation"
+)
+
+var (
+	_ types.Element = (*OLEObject)(nil)
+	_ types.Media   = (*OLEObject)(nil)
+)
+
+// OLEObject is an embedded object (spreadsheet, document, ...) shown as an icon
+// that opens in its application on double-click
+type OLEObject struct {
+	RelationshipID string // Relationship ID of the embedding in document.xml.rels
+	Index          int    // Embedding number, used for the file name and shape ID
+	ProgID         string // Programmatic ID of the server application (e.g. "Excel.Sheet.12")
+	Data           []byte // The embedded file or OLE compound file
+	Icon           *Image // Image shown in place of the object
+}
+
+// NewOLEObject creates an embedded object. Icon must be registered with the document.
+func NewOLEObject(index int, data []byte, progID string, icon *Image) (*OLEObject, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("embedded object data must not be empty")
+	}
+	if progID == "" {
+		return nil, fmt.Errorf("embedded object requires a ProgID")
+	}
+	if icon == nil {
+		return nil, fmt.Errorf("embedded object requires an icon image")
+	}
+	return &OLEObject{
+		Index:  index,
+		ProgID: progID,
+		Data:   data,
+		Icon:   icon,
+	}, nil
+}
+
+// IsPackage reports whether the data is an Office Open XML package (zip) rather than an OLE compound file
+func (o *OLEObject) IsPackage() bool {
+	return bytes.HasPrefix(o.Data, []byte("PK\x03\x04"))
+}
+
+// Extension returns the file extension of the embedding
+func (o *OLEObject) Extension() string {
+	if !o.IsPackage() {
+		return "bin"
+	}
+	switch {
+	case strings.HasPrefix(o.ProgID, "Word."):
+		return "docx"
+	case strings.HasPrefix(o.ProgID, "PowerPoint."):
+		return "pptx"
+	default:
+		return "xlsx"
+	}
+}
+
+// MediaType returns the content type of the embedding
+func (o *OLEObject) MediaType() string {
+	switch o.Extension() {
+	case "docx":
+		return ContentTypeDOCX
+	case "pptx":
+		return ContentTypePPTX
+	case "xlsx":
+		return ContentTypeXLSX
+	default:
+		return ContentTypeOLEObject
+	}
+}
+
+// Type returns the element type
+func (o *OLEObject) Type() string {
+	return "oleObject"
+}
+
+// XML generates the run holding the VML icon shape and the OLE object reference
+func (o *OLEObject) XML() ([]byte, error) {
+	if o.Icon == nil {
+		return nil, fmt.Errorf("embedded object %d has no icon", o.Index)
+	}
+
+	// Icon size in points (12700 EMUs per point)
+	widthPt := float64(o.Icon.Width) / 12700
+	heightPt := float64(o.Icon.Height) / 12700
+	shapeID := fmt.Sprintf("_x0000_i%d", 1024+o.Index)
+
+	var buf bytes.Buffer
+	buf.WriteString(`<w:r>`)
+	fmt.Fprintf(&buf, `<w:object xmlns:v="urn:schemas-microsoft-com:vml" xmlns:o="urn:schemas-microsoft-com:office:office" w:dxaOrig="%d" w:dyaOrig="%d">`,
+		int(widthPt*20), int(heightPt*20))
+	fmt.Fprintf(&buf, `<v:shape id="%s" type="#_x0000_t75" style="width:%.2fpt;height:%.2fpt" o:ole="">`,
+		shapeID, widthPt, heightPt)
+	fmt.Fprintf(&buf, `<v:imagedata r:id="%s" o:title=""/>`, o.Icon.RelationshipID)
+	buf.WriteString(`</v:shape>`)
+	fmt.Fprintf(&buf, `<o:OLEObject Type="Embed" ProgID="%s" ShapeID="%s" DrawAspect="Icon" ObjectID="_%d" r:id="%s"/>`,
+		escapeXMLAttribute(o.ProgID), shapeID, 1000000000+o.Index, o.RelationshipID)
+	buf.WriteString(`</w:object>`)
+	buf.WriteString(`</w:r>`)
+
+	return buf.Bytes(), nil
+}
+
+// RelID returns the relationship ID
+func (o *OLEObject) RelID() string {
+	return o.RelationshipID
+}
+
+// RelType returns the relationship type
+func (o *OLEObject) RelType() string {
+	if o.IsPackage() {
+		return relationships.TypePackage
+	}
+	return relationships.TypeOLEObject
+}
+
+// TargetPath returns the directory of the embedding inside the package
+func (o *OLEObject) TargetPath() string {
+	return "word/embeddings/"
+}
+
+// FileName returns the embedding file name
+func (o *OLEObject) FileName() string {
+	return fmt.Sprintf("embedding%d.%s", o.Index, o.Extension())
+}
+
+// RawContent returns the embedded data
+func (o *OLEObject) RawContent() []byte {
+	return o.Data
+}
@@ -2,8 +2,10 @@
 package elements
 
 import (
-	"bytes"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/didikprabowo/mbadocx/properties"
 	"github.com/didikprabowo/mbadocx/types"
@@ -19,11 +21,46 @@ const (
 	ListTypeCustom  ListType = "Custom symbols"
 )
 
+// ParagraphAlignment is the horizontal alignment of a paragraph
+type ParagraphAlignment string
+
+// Paragraph alignment options
+const (
+	AlignmentLeft       ParagraphAlignment = "left"
+	AlignmentCenter     ParagraphAlignment = "center"
+	AlignmentRight      ParagraphAlignment = "right"
+	AlignmentJustify    ParagraphAlignment = "both"
+	AlignmentDistribute ParagraphAlignment = "distribute"
+	AlignmentStart      ParagraphAlignment = "start"
+	AlignmentEnd        ParagraphAlignment = "end"
+)
+
+// ErrInvalidAlignment is reported when an unknown paragraph alignment is set
+var ErrInvalidAlignment = errors.New("invalid paragraph alignment")
+
+// ParseParagraphAlignment converts a string such as "center" to a ParagraphAlignment,
+// for alignments that come from variables or configuration. "justify" maps to
+// AlignmentJustify ("both"); unknown values such as "centre" are rejected.
+func ParseParagraphAlignment(s string) (ParagraphAlignment, error) {
+	alignment := ParagraphAlignment(strings.ToLower(strings.TrimSpace(s)))
+	switch alignment {
+	case "justify":
+		// Map "justify" to "both" for DOCX compatibility
+		return AlignmentJustify, nil
+	case AlignmentLeft, AlignmentCenter, AlignmentRight, AlignmentJustify,
+		AlignmentDistribute, AlignmentStart, AlignmentEnd:
+		return alignment, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidAlignment, s)
+}
+
 // Paragraph represents a paragraph element
 type Paragraph struct {
 	document   types.Document
 	Properties *properties.ParagraphProperties
 	Children   []ParagraphChild
+
+	err error // First invalid setter argument, reported by Err and Validate
 }
 
 // ParagraphChild interface for elements that can be children of a paragraph
@@ -49,12 +86,19 @@ func (p *Paragraph) Type() string {
 // AddRun adds a new run to the paragraph
 func (p *Paragraph) AddRun() *Run {
 	r := NewRun()
+	r.paragraph = p
 	p.Children = append(p.Children, r)
 	return r
 }
 
 // AddChildren
 func (p *Paragraph) AddChildren(child ParagraphChild) {
+	switch c := child.(type) {
+	case *Run:
+		c.paragraph = p
+	case *Hyperlink:
+		c.setParagraph(p)
+	}
 	p.Children = append(p.Children, child)
 }
 
@@ -65,6 +109,13 @@ func (p *Paragraph) AddText(text string) *Run {
 	return r
 }
 
+// AddBoundText adds a plain text content control bound to a node of a custom XML part
+func (p *Paragraph) AddBoundText(part *CustomXML, xpath, text string) *BoundText {
+	b := NewBoundText(part.StoreItemID, xpath, text)
+	p.Children = append(p.Children, b)
+	return b
+}
+
 // AddHyperlink
 func (pb *Paragraph) AddHyperlink(text, url string) *Paragraph {
 	h := NewHyperlink(text, url)
@@ -74,10 +125,26 @@ func (pb *Paragraph) AddHyperlink(text, url string) *Paragraph {
 		h.ID = rel.ID
 	}
 
+	h.setParagraph(pb)
 	pb.Children = append(pb.Children, h)
 	return pb
 }
 
+// AddHyperlinkFormatted adds a hyperlink and lets format restyle its text runs, e.g. to change the default blue underline
+func (pb *Paragraph) AddHyperlinkFormatted(text, url string, format func(*Run)) *Paragraph {
+	pb.AddHyperlink(text, url)
+
+	h := pb.Children[len(pb.Children)-1].(*Hyperlink)
+	if format != nil {
+		for _, child := range h.Children {
+			if r, ok := child.(*Run); ok {
+				format(r)
+			}
+		}
+	}
+	return pb
+}
+
 // AddFormattedText adds text with specific formatting
 func (p *Paragraph) AddFormattedText(text string, format func(*Run)) *Run {
 	r := p.AddRun()
@@ -88,6 +155,21 @@ func (p *Paragraph) AddFormattedText(text string, format func(*Run)) *Run {
 	return r
 }
 
+// Append adds a run of text formatted by opts and returns the paragraph for chaining
+func (p *Paragraph) Append(text string, opts ...RunOption) *Paragraph {
+	run := p.AddText(text)
+	for _, opt := range opts {
+		opt(run)
+	}
+	return p
+}
+
+// WithText adds a plain text run and returns the paragraph, keeping list-building chains fluent
+func (p *Paragraph) WithText(text string) *Paragraph {
+	p.AddText(text)
+	return p
+}
+
 // AddLineBreak adds a paragraph
 func (p *Paragraph) AddLineBreak() *Paragraph {
 	run := p.AddRun()
@@ -102,16 +184,27 @@ func (p *Paragraph) AddPageBreak() *Paragraph {
 	return p
 }
 
-// SetAlignment sets the paragraph alignment
-func (p *Paragraph) SetAlignment(alignment string) *Paragraph {
-	// Map "justify" to "both" for DOCX compatibility
-	if alignment == "justify" {
-		alignment = "both"
+// SetAlignment sets the paragraph alignment, one of the Alignment constants.
+// An unknown value leaves the alignment unchanged and is reported by Err right
+// away; convert strings with ParseParagraphAlignment to get the error directly.
+func (p *Paragraph) SetAlignment(alignment ParagraphAlignment) *Paragraph {
+	parsed, err := ParseParagraphAlignment(string(alignment))
+	if err != nil {
+		if p.err == nil {
+			p.err = err
+		}
+		return p
 	}
-	p.Properties.Alignment = alignment
+	p.Properties.Alignment = string(parsed)
 	return p
 }
 
+// Err returns the first error recorded by a setter, such as an invalid alignment.
+// The rejected setting is not applied, so the paragraph can still be saved.
+func (p *Paragraph) Err() error {
+	return p.err
+}
+
 // SetStyle sets the paragraph style
 func (p *Paragraph) SetStyle(styleID string) *Paragraph {
 	p.Properties.StyleID = styleID
@@ -125,6 +218,13 @@ func (p *Paragraph) SetSpacing(before, after float64) *Paragraph {
 	return p
 }
 
+// SetAutoSpacing enables Word's HTML-style automatic spacing before and/or after the paragraph
+func (p *Paragraph) SetAutoSpacing(before, after bool) *Paragraph {
+	p.Properties.SpacingBeforeAuto = before
+	p.Properties.SpacingAfterAuto = after
+	return p
+}
+
 // SetLineSpacing sets the line spacing
 func (p *Paragraph) SetLineSpacing(spacing float64, rule string) *Paragraph {
 	p.Properties.LineSpacing = spacing
@@ -132,17 +232,64 @@ func (p *Paragraph) SetLineSpacing(spacing float64, rule string) *Paragraph {
 	return p
 }
 
-// SetIndentation sets paragraph indentation
+// SetLineSpacingExact sets a fixed line height in points
+func (p *Paragraph) SetLineSpacingExact(pts float64) *Paragraph {
+	p.Properties.SetLineSpacingExact(pts)
+	return p
+}
+
+// SetLineSpacingAtLeast sets a minimum line height in points
+func (p *Paragraph) SetLineSpacingAtLeast(pts float64) *Paragraph {
+	p.Properties.SetLineSpacingAtLeast(pts)
+	return p
+}
+
+// SetLineSpacingSingle sets single line spacing
+func (p *Paragraph) SetLineSpacingSingle() *Paragraph {
+	p.Properties.SetLineSpacingSingle()
+	return p
+}
+
+// SetLineSpacingOneAndHalf sets 1.5 line spacing
+func (p *Paragraph) SetLineSpacingOneAndHalf() *Paragraph {
+	p.Properties.SetLineSpacingOneAndHalf()
+	return p
+}
+
+// SetLineSpacingDouble sets double line spacing
+func (p *Paragraph) SetLineSpacingDouble() *Paragraph {
+	p.Properties.SetLineSpacingDouble()
+	return p
+}
+
+// SetIndentation sets paragraph indentation; a negative firstLine is stored as a hanging indent
 func (p *Paragraph) SetIndentation(left, right, firstLine float64) *Paragraph {
 	p.Properties.IndentLeft = left
 	p.Properties.IndentRight = right
-	p.Properties.IndentFirstLine = firstLine
+	if firstLine < 0 {
+		p.Properties.IndentFirstLine = 0
+		p.Properties.IndentHanging = -firstLine
+	} else {
+		p.Properties.IndentFirstLine = firstLine
+		p.Properties.IndentHanging = 0
+	}
 	return p
 }
 
-// SetHangingIndent sets a hanging indent
+// SetHangingIndent sets a hanging indent, replacing any first-line indent.
+// When no left indent is set, the body is indented by the hanging amount so the first line stays at the margin.
 func (p *Paragraph) SetHangingIndent(hanging float64) *Paragraph {
-	p.Properties.IndentFirstLine = -hanging
+	p.Properties.IndentFirstLine = 0
+	p.Properties.IndentHanging = hanging
+	if p.Properties.IndentLeft == 0 {
+		p.Properties.IndentLeft = hanging
+	}
+	return p
+}
+
+// SetOutdent moves the left edge of the paragraph into the left margin by pts points
+func (p *Paragraph) SetOutdent(pts float64) *Paragraph {
+	p.Properties.IndentLeft = -pts
 	return p
 }
 
@@ -164,9 +311,10 @@ func (p *Paragraph) SetPageBreakBefore(pageBreak bool) *Paragraph {
 	return p
 }
 
-// SetWidowControl sets widow/orphan control
+// SetWidowControl sets widow/orphan control, overriding the paragraph style either way
 func (p *Paragraph) SetWidowControl(control bool) *Paragraph {
 	p.Properties.WidowControl = control
+	p.Properties.WidowControlSet = true
 	return p
 }
 
@@ -198,9 +346,33 @@ func (p *Paragraph) SetNumbering(listType ListType, level int) *Paragraph {
 	return p
 }
 
-// SetOutlineLevel sets the outline level for TOC
+// SetNumberingID numbers the paragraph with a numbering instance by ID, such as a list returned by Document.DefineList
+func (p *Paragraph) SetNumberingID(numID, level int) *Paragraph {
+	p.Properties.NumberingID = strconv.Itoa(numID)
+	p.Properties.NumberingLevel = level
+	return p
+}
+
+// SetOutlineLevel sets the outline level used by the TOC and navigation pane.
+//
+// The level is 0-based, as written to <w:outlineLvl>: 0 is Heading 1 and 8 is
+// Heading 9. Earlier versions were 1-based, so code that passed 1 for Heading 1
+// must now pass 0. A level outside 0-8 leaves the paragraph unchanged and is
+// reported by Err.
 func (p *Paragraph) SetOutlineLevel(level int) *Paragraph {
-	p.Properties.OutlineLevel = level
+	if level < 0 || level > 8 {
+		if p.err == nil {
+			p.err = fmt.Errorf("outline level must be between 0 and 8: %d", level)
+		}
+		return p
+	}
+	p.Properties.OutlineLevel = &level
+	return p
+}
+
+// ClearOutlineLevel resets the paragraph to body text level
+func (p *Paragraph) ClearOutlineLevel() *Paragraph {
+	p.Properties.OutlineLevel = nil
 	return p
 }
 
@@ -216,15 +388,65 @@ func (p *Paragraph) SetShading(shading *properties.ParagraphShading) *Paragraph
 	return p
 }
 
+// SetMarkProperties sets the formatting of the paragraph mark, which also sizes the line of an empty paragraph
+func (p *Paragraph) SetMarkProperties(props *properties.RunProperties) *Paragraph {
+	p.Properties.MarkProperties = props.Clone()
+	return p
+}
+
 // SetTabs sets custom tab stops
 func (p *Paragraph) SetTabs(tabs []properties.TabStop) *Paragraph {
 	p.Properties.Tabs = tabs
 	return p
 }
 
+// AddTabbedText adds one run per segment and the tab stops that position them.
+// Positions must increase from segment to segment and only the first segment may
+// use position 0; on error nothing is added to the paragraph.
+func (p *Paragraph) AddTabbedText(segments []TabSegment) error {
+	stops := make([]properties.TabStop, len(segments))
+	last := -1
+	for i, seg := range segments {
+		if seg.Position <= last || (seg.Position == 0 && i > 0) {
+			return fmt.Errorf("tab segment %d: position %d must be greater than %d", i, seg.Position, last)
+		}
+		last = seg.Position
+
+		alignment := seg.Alignment
+		if alignment == "" {
+			alignment = "left"
+		}
+		stops[i] = properties.TabStop{Position: seg.Position, Alignment: alignment, Leader: seg.Leader}
+		if err := stops[i].Validate(); err != nil {
+			return fmt.Errorf("tab segment %d: %w", i, err)
+		}
+	}
+
+	for i, seg := range segments {
+		r := p.AddRun()
+		if seg.Position > 0 {
+			p.Properties.Tabs = append(p.Properties.Tabs, stops[i])
+			r.AddTab()
+		}
+		r.AddText(seg.Text)
+	}
+	return nil
+}
+
+// CopyPropertiesFrom replaces the paragraph formatting (style, spacing, indentation, ...) with a copy of other's
+func (p *Paragraph) CopyPropertiesFrom(other *Paragraph) *Paragraph {
+	if other == nil || other.Properties == nil {
+		return p
+	}
+	p.Properties = other.Properties.Clone()
+	return p
+}
+
 // Clone creates a deep copy of the paragraph
 func (p *Paragraph) Clone() *Paragraph {
 	newPara := &Paragraph{
+		document:   p.document,
+		err:        p.err,
 		Properties: p.Properties.Clone(),
 		Children:   make([]ParagraphChild, 0, len(p.Children)),
 	}
@@ -233,9 +455,13 @@ func (p *Paragraph) Clone() *Paragraph {
 	for _, child := range p.Children {
 		switch c := child.(type) {
 		case *Run:
-			newPara.Children = append(newPara.Children, c.Clone())
+			run := c.Clone()
+			run.paragraph = newPara
+			newPara.Children = append(newPara.Children, run)
 		case *Hyperlink:
-			newPara.Children = append(newPara.Children, c.Clone())
+			link := c.Clone()
+			link.setParagraph(newPara)
+			newPara.Children = append(newPara.Children, link)
 			// Add other child types as needed
 		}
 	}
@@ -248,8 +474,65 @@ func (p *Paragraph) Clear() {
 	p.Children = p.Children[:0]
 }
 
+// Text returns the plain text content of the paragraph
+func (p *Paragraph) Text() string {
+	var sb strings.Builder
+	for _, child := range p.Children {
+		switch c := child.(type) {
+		case *Run:
+			sb.WriteString(c.Text())
+		case *BoundText:
+			sb.WriteString(c.Text)
+		case *Hyperlink:
+			for _, hc := range c.Children {
+				if r, ok := hc.(*Run); ok {
+					sb.WriteString(r.Text())
+				}
+			}
+		}
+	}
+	return sb.String()
+}
+
+// UsesNumbering reports whether the paragraph is a list item
+func (p *Paragraph) UsesNumbering() bool {
+	return p.Properties != nil && p.Properties.NumberingID != ""
+}
+
+// CoalesceRuns merges consecutive runs with identical formatting into a single run
+func (p *Paragraph) CoalesceRuns() *Paragraph {
+	children := make([]ParagraphChild, 0, len(p.Children))
+
+	var prev *Run
+	var prevProps string
+	for _, child := range p.Children {
+		r, ok := child.(*Run)
+		if !ok {
+			children = append(children, child)
+			prev = nil
+			continue
+		}
+
+		props := r.formattingKey()
+		if prev != nil && props == prevProps {
+			prev.appendChildren(r.Children)
+			continue
+		}
+
+		children = append(children, r)
+		prev, prevProps = r, props
+	}
+
+	p.Children = children
+	return p
+}
+
 // Validate checks if the paragraph is valid
 func (p *Paragraph) Validate() error {
+	if p.err != nil {
+		return p.err
+	}
+
 	if p.Properties != nil {
 		if err := p.Properties.Validate(); err != nil {
 			return fmt.Errorf("invalid paragraph properties: %w", err)
@@ -270,7 +553,8 @@ func (p *Paragraph) Validate() error {
 
 // XML generates the XML representation of the paragraph
 func (p *Paragraph) XML() ([]byte, error) {
-	var buf bytes.Buffer
+	buf := getBuffer()
+	defer putBuffer(buf)
 
 	// Start with XML declaration
 	buf.WriteString(`<w:p xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"`)
@@ -296,7 +580,7 @@ func (p *Paragraph) XML() ([]byte, error) {
 		if err != nil {
 			return nil, fmt.Errorf("generating properties XML: %w", err)
 		}
-		buf.Write(propXML)
+		buf.WriteString(propXML)
 	}
 
 	// Add children (runs, hyperlinks, etc.)
@@ -311,22 +595,23 @@ func (p *Paragraph) XML() ([]byte, error) {
 	// Close paragraph tag
 	buf.WriteString(`</w:p>`)
 
-	return buf.Bytes(), nil
+	return detachBytes(buf), nil
 }
 
 // generatePropertiesXML generates the properties XML
-func (p *Paragraph) generatePropertiesXML() ([]byte, error) {
+func (p *Paragraph) generatePropertiesXML() (string, error) {
 	pp := p.Properties
 	if pp == nil {
-		return nil, nil
+		return "", nil
 	}
 
-	var buf bytes.Buffer
+	var buf strings.Builder
+	buf.Grow(256)
 	buf.WriteString(`<w:pPr>`)
 
 	// Style
 	if pp.StyleID != "" {
-		buf.WriteString(fmt.Sprintf(`<w:pStyle w:val="%s"/>`, pp.StyleID))
+		fmt.Fprintf(&buf, `<w:pStyle w:val="%s"/>`, pp.StyleID)
 	}
 
 	// Keep properties
@@ -340,16 +625,18 @@ func (p *Paragraph) generatePropertiesXML() ([]byte, error) {
 		buf.WriteString(`<w:pageBreakBefore/>`)
 	}
 
-	// Widow control
+	// Widow control; "on" is only written when set explicitly, since it is the usual style default
 	if !pp.WidowControl {
 		buf.WriteString(`<w:widowControl w:val="false"/>`)
+	} else if pp.WidowControlSet {
+		buf.WriteString(`<w:widowControl/>`)
 	}
 
 	// Numbering
 	if pp.NumberingID != "" {
 		buf.WriteString(`<w:numPr>`)
-		buf.WriteString(fmt.Sprintf(`<w:ilvl w:val="%d"/>`, pp.NumberingLevel))
-		buf.WriteString(fmt.Sprintf(`<w:numId w:val="%s"/>`, pp.NumberingID))
+		fmt.Fprintf(&buf, `<w:ilvl w:val="%d"/>`, pp.NumberingLevel)
+		fmt.Fprintf(&buf, `<w:numId w:val="%s"/>`, pp.NumberingID)
 		buf.WriteString(`</w:numPr>`)
 	}
 
@@ -357,7 +644,7 @@ func (p *Paragraph) generatePropertiesXML() ([]byte, error) {
 	if pp.Borders != nil {
 		bordersXML, err := pp.Borders.XML()
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		buf.Write(bordersXML)
 	}
@@ -366,7 +653,7 @@ func (p *Paragraph) generatePropertiesXML() ([]byte, error) {
 	if pp.Shading != nil {
 		shadingXML, err := pp.Shading.XML()
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		buf.Write(shadingXML)
 	}
@@ -375,9 +662,9 @@ func (p *Paragraph) generatePropertiesXML() ([]byte, error) {
 	if len(pp.Tabs) > 0 {
 		buf.WriteString(`<w:tabs>`)
 		for _, tab := range pp.Tabs {
-			buf.WriteString(fmt.Sprintf(`<w:tab w:val="%s" w:pos="%d"`, tab.Alignment, tab.Position))
+			fmt.Fprintf(&buf, `<w:tab w:val="%s" w:pos="%d"`, tab.Alignment, tab.Position)
 			if tab.Leader != "" {
-				buf.WriteString(fmt.Sprintf(` w:leader="%s"`, tab.Leader))
+				fmt.Fprintf(&buf, ` w:leader="%s"`, tab.Leader)
 			}
 			buf.WriteString(`/>`)
 		}
@@ -391,30 +678,31 @@ func (p *Paragraph) generatePropertiesXML() ([]byte, error) {
 
 	// Alignment
 	if pp.Alignment != "" && pp.Alignment != "left" {
-		buf.WriteString(fmt.Sprintf(`<w:jc w:val="%s"/>`, pp.Alignment))
+		fmt.Fprintf(&buf, `<w:jc w:val="%s"/>`, pp.Alignment)
 	}
 
 	// Outline level
-	if pp.OutlineLevel > 0 {
-		buf.WriteString(fmt.Sprintf(`<w:outlineLvl w:val="%d"/>`, pp.OutlineLevel-1)) // 0-based in XML
+	if pp.OutlineLevel != nil {
+		fmt.Fprintf(&buf, `<w:outlineLvl w:val="%d"/>`, *pp.OutlineLevel)
 	}
 
 	// Indentation
-	if pp.IndentLeft != 0 || pp.IndentRight != 0 || pp.IndentFirstLine != 0 {
+	if pp.IndentLeft != 0 || pp.IndentRight != 0 || pp.IndentFirstLine != 0 || pp.IndentHanging != 0 {
 		buf.WriteString(`<w:ind`)
 
 		if pp.IndentLeft != 0 {
-			buf.WriteString(fmt.Sprintf(` w:left="%d"`, int(pp.IndentLeft*20))) // Convert to twips
+			fmt.Fprintf(&buf, ` w:left="%d"`, int(pp.IndentLeft*20)) // Convert to twips
 		}
 
 		if pp.IndentRight != 0 {
-			buf.WriteString(fmt.Sprintf(` w:right="%d"`, int(pp.IndentRight*20)))
+			fmt.Fprintf(&buf, ` w:right="%d"`, int(pp.IndentRight*20))
 		}
 
-		if pp.IndentFirstLine > 0 {
-			buf.WriteString(fmt.Sprintf(` w:firstLine="%d"`, int(pp.IndentFirstLine*20)))
-		} else if pp.IndentFirstLine < 0 {
-			buf.WriteString(fmt.Sprintf(` w:hanging="%d"`, int(-pp.IndentFirstLine*20)))
+		// firstLine and hanging are mutually exclusive; hanging wins
+		if hanging := pp.EffectiveHanging(); hanging > 0 {
+			fmt.Fprintf(&buf, ` w:hanging="%d"`, int(hanging*20))
+		} else if pp.IndentFirstLine > 0 {
+			fmt.Fprintf(&buf, ` w:firstLine="%d"`, int(pp.IndentFirstLine*20))
 		}
 
 		buf.WriteString(`/>`)
@@ -425,31 +713,28 @@ func (p *Paragraph) generatePropertiesXML() ([]byte, error) {
 	if needsSpacing {
 		buf.WriteString(`<w:spacing`)
 
-		// Write before spacing (including 0 for table cells)
-		if pp.SpacingBefore == 0 {
+		// Write before spacing (including 0 for table cells); auto spacing overrides the value
+		if pp.SpacingBeforeAuto {
+			buf.WriteString(` w:beforeAutospacing="1"`)
+		} else if pp.SpacingBefore == 0 {
 			buf.WriteString(` w:before="0"`)
 		} else if pp.SpacingBefore > 0 {
-			buf.WriteString(fmt.Sprintf(` w:before="%d"`, int(pp.SpacingBefore*20)))
+			fmt.Fprintf(&buf, ` w:before="%d"`, int(pp.SpacingBefore*20))
 		}
 
-		// Write after spacing (including 0 for table cells)
-		if pp.SpacingAfter == 0 {
+		// Write after spacing (including 0 for table cells); auto spacing overrides the value
+		if pp.SpacingAfterAuto {
+			buf.WriteString(` w:afterAutospacing="1"`)
+		} else if pp.SpacingAfter == 0 {
 			buf.WriteString(` w:after="0"`)
 		} else if pp.SpacingAfter > 0 {
-			buf.WriteString(fmt.Sprintf(` w:after="%d"`, int(pp.SpacingAfter*20)))
+			fmt.Fprintf(&buf, ` w:after="%d"`, int(pp.SpacingAfter*20))
 		}
 
 		// Line spacing
 		if pp.LineSpacing > 0 {
-			switch pp.LineSpacingRule {
-			case "exact":
-				buf.WriteString(fmt.Sprintf(` w:line="%d" w:lineRule="exact"`, int(pp.LineSpacing*20)))
-			case "atLeast":
-				buf.WriteString(fmt.Sprintf(` w:line="%d" w:lineRule="atLeast"`, int(pp.LineSpacing*20)))
-			default: // auto
-				// For auto, 240 = single space, 276 = 1.15, 360 = 1.5, 480 = double
-				buf.WriteString(fmt.Sprintf(` w:line="%d" w:lineRule="auto"`, int(pp.LineSpacing*240)))
-			}
+			line, rule := properties.LineSpacingValue(pp.LineSpacing, pp.LineSpacingRule)
+			fmt.Fprintf(&buf, ` w:line="%d" w:lineRule="%s"`, line, rule)
 		} else {
 			// Default line spacing if not set
 			buf.WriteString(` w:line="276" w:lineRule="auto"`)
@@ -458,7 +743,25 @@ func (p *Paragraph) generatePropertiesXML() ([]byte, error) {
 		buf.WriteString(`/>`)
 	}
 
+	// Paragraph mark formatting
+	if pp.MarkProperties != nil {
+		markXML, err := runPropertiesXML(pp.MarkProperties)
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(markXML)
+	}
+
+	// Section break; the paragraph is the last one of the section
+	if pp.SectionProperties != nil {
+		sectXML, err := pp.SectionProperties.XML()
+		if err != nil {
+			return "", err
+		}
+		buf.Write(sectXML)
+	}
+
 	buf.WriteString(`</w:pPr>`)
 
-	return buf.Bytes(), nil
+	return buf.String(), nil
 }
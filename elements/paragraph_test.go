@@ -0,0 +1,18 @@
+package elements
+
+import "testing"
+
+func BenchmarkParagraphXML(b *testing.B) {
+	p := NewParagraph(nil)
+	p.AddText("Plain text, ")
+	p.AddText("bold text").SetBold(true)
+	p.AddText(" and italic text.").SetItalic(true)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := p.XML(); err != nil {
+			b.Fatalf("XML() error = %v", err)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package elements
+
+import (
+	"github.com/didikprabowo/mbadocx/types"
+)
+
+var _ types.Media = (*Part)(nil)
+
+// Part represents a raw package part (chart relationships, embedded
+// workbooks, etc.) that is written verbatim into the DOCX archive.
+type Part struct {
+	RelationshipID   string // Relationship ID, empty when the part is not referenced from document.xml
+	RelationshipType string // Relationship type
+	Dir              string // Directory inside the package, e.g. "word/charts/_rels/"
+	Name             string // File name inside Dir
+	Content          []byte // Raw part content
+}
+
+// NewPart creates a new raw package part
+func NewPart(dir, name string, content []byte) *Part {
+	return &Part{
+		Dir:     dir,
+		Name:    name,
+		Content: content,
+	}
+}
+
+// RelID returns the relationship ID
+func (p *Part) RelID() string {
+	return p.RelationshipID
+}
+
+// RelType returns the relationship type
+func (p *Part) RelType() string {
+	return p.RelationshipType
+}
+
+// TargetPath returns the directory of the part inside the package
+func (p *Part) TargetPath() string {
+	return p.Dir
+}
+
+// FileName returns the file name
+func (p *Part) FileName() string {
+	return p.Name
+}
+
+// RawContent returns the raw part content
+func (p *Part) RawContent() []byte {
+	return p.Content
+}
@@ -0,0 +1,167 @@
+package elements
+
+import "strings"
+
+// ReplaceText replaces every occurrence of old with new in the paragraph text,
+// keeping the formatting of the runs it appears in. It returns the number of replacements.
+// Occurrences spanning runs with different formatting are not matched.
+func (p *Paragraph) ReplaceText(old, new string) int {
+	if old == "" {
+		return 0
+	}
+
+	count := 0
+	for _, child := range p.Children {
+		switch c := child.(type) {
+		case *Run:
+			count += c.replaceText(old, new)
+		case *Hyperlink:
+			for _, hc := range c.Children {
+				if r, ok := hc.(*Run); ok {
+					count += r.replaceText(old, new)
+				}
+			}
+		}
+	}
+	return count
+}
+
+// ReplaceTextFormatted replaces every occurrence of old with new placed in its own run,
+// formatted by format on top of the surrounding run formatting. It returns the number of replacements.
+func (p *Paragraph) ReplaceTextFormatted(old, new string, format func(*Run)) int {
+	if old == "" {
+		return 0
+	}
+
+	children, count := replaceRunsFormatted(p.Children, old, new, format)
+	p.Children = children
+	return count
+}
+
+// replaceRunsFormatted splits the runs among children around each occurrence of old,
+// descending into hyperlinks so linked text is replaced too
+func replaceRunsFormatted(children []ParagraphChild, old, new string, format func(*Run)) ([]ParagraphChild, int) {
+	count := 0
+	result := make([]ParagraphChild, 0, len(children))
+	for _, child := range children {
+		switch c := child.(type) {
+		case *Run:
+			runs, n := c.splitReplace(old, new, format)
+			if n == 0 {
+				result = append(result, c)
+				continue
+			}
+			for _, split := range runs {
+				result = append(result, split)
+			}
+			count += n
+		case *Hyperlink:
+			var n int
+			c.Children, n = replaceRunsFormatted(c.Children, old, new, format)
+			count += n
+			result = append(result, c)
+		default:
+			result = append(result, child)
+		}
+	}
+	return result, count
+}
+
+// ReplaceText replaces text in every cell of the table and returns the number of replacements
+func (t *Table) ReplaceText(old, new string) int {
+	count := 0
+	for _, row := range t.Rows {
+		for _, cell := range row.Cells {
+			for _, p := range cell.Paragraphs {
+				count += p.ReplaceText(old, new)
+			}
+		}
+	}
+	return count
+}
+
+// ReplaceTextFormatted replaces text with formatted runs in every cell of the table
+func (t *Table) ReplaceTextFormatted(old, new string, format func(*Run)) int {
+	count := 0
+	for _, row := range t.Rows {
+		for _, cell := range row.Cells {
+			for _, p := range cell.Paragraphs {
+				count += p.ReplaceTextFormatted(old, new, format)
+			}
+		}
+	}
+	return count
+}
+
+// replaceText replaces old with new inside the run's text children
+func (r *Run) replaceText(old, new string) int {
+	count := 0
+	for _, child := range r.Children {
+		t, ok := child.(*Text)
+		if !ok || !strings.Contains(t.Value, old) {
+			continue
+		}
+		count += strings.Count(t.Value, old)
+		*t = *NewText(strings.ReplaceAll(t.Value, old, new))
+	}
+	return count
+}
+
+// splitReplace splits the run around each occurrence of old, inserting a run holding new.
+// It returns nil and 0 when the run does not contain old.
+func (r *Run) splitReplace(old, new string, format func(*Run)) ([]*Run, int) {
+	if !strings.Contains(r.Text(), old) {
+		return nil, 0
+	}
+
+	var runs []*Run
+	count := 0
+	current := &Run{Properties: r.Properties.Clone(), paragraph: r.paragraph}
+
+	flush := func() {
+		if len(current.Children) > 0 {
+			runs = append(runs, current)
+		}
+		current = &Run{Properties: r.Properties.Clone(), paragraph: r.paragraph}
+	}
+
+	for _, child := range r.Children {
+		t, ok := child.(*Text)
+		if !ok {
+			current.Children = append(current.Children, child)
+			continue
+		}
+
+		value := t.Value
+		for {
+			i := strings.Index(value, old)
+			if i < 0 {
+				break
+			}
+			if i > 0 {
+				current.AddText(value[:i])
+			}
+			flush()
+
+			replacement := &Run{Properties: r.Properties.Clone(), paragraph: r.paragraph}
+			replacement.AddText(new)
+			if format != nil {
+				format(replacement)
+			}
+			runs = append(runs, replacement)
+			count++
+
+			value = value[i+len(old):]
+		}
+		if value != "" {
+			current.AddText(value)
+		}
+	}
+	flush()
+
+	if count == 0 {
+		// old only matched across text children; leave the run untouched
+		return nil, 0
+	}
+	return runs, count
+}
@@ -2,10 +2,10 @@
 package elements
 
 import (
-	"bytes"
 	"fmt"
 	"strings"
 
+	"github.com/didikprabowo/mbadocx/colors"
 	"github.com/didikprabowo/mbadocx/properties"
 )
 
@@ -13,6 +13,9 @@ import (
 type Run struct {
 	Properties *properties.RunProperties
 	Children   []RunChild
+
+	paragraph *Paragraph // Parent paragraph, used to resolve inherited formatting
+	err       error      // First invalid setter argument, reported by Err and Validate
 }
 
 // RunChild interface for elements that can be children of a run
@@ -34,6 +37,19 @@ func (r *Run) Type() string {
 	return "run"
 }
 
+// Err returns the first error recorded by a setter, such as an invalid color.
+// The rejected setting is not applied, so the run can still be saved.
+func (r *Run) Err() error {
+	return r.err
+}
+
+// setErr records err unless an earlier setter already failed
+func (r *Run) setErr(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
 // AddText adds text to the run
 func (r *Run) AddText(text string) *Run {
 	t := NewText(text)
@@ -107,9 +123,71 @@ func (r *Run) SetFontFamily(font string) *Run {
 	return r
 }
 
-// SetColor sets the text color (hex format, e.g., "FF0000" for red)
+// Apply merges the set fields of props into the run formatting; props is copied so it can be shared across runs
+func (r *Run) Apply(props *properties.RunProperties) *Run {
+	if props == nil {
+		return r
+	}
+	if r.Properties == nil {
+		r.Properties = properties.NewRunProperties()
+	}
+	r.Properties.Merge(props.Clone())
+	return r
+}
+
+// EffectiveProperties returns the resolved run formatting: the document default
+// style, then the paragraph style, then the character style, then the run's own properties
+func (r *Run) EffectiveProperties() *properties.RunProperties {
+	effective := &properties.RunProperties{}
+
+	if r.paragraph != nil && r.paragraph.document != nil {
+		if docStyles := r.paragraph.document.Styles(); docStyles != nil {
+			s := docStyles.Get()
+			effective.Merge(s.RunProperties(""))
+			if r.paragraph.Properties != nil && r.paragraph.Properties.StyleID != "" {
+				effective.Merge(s.RunProperties(r.paragraph.Properties.StyleID))
+			}
+			if r.Properties != nil && r.Properties.StyleID != "" {
+				effective.Merge(s.RunProperties(r.Properties.StyleID))
+			}
+		}
+	}
+
+	effective.Merge(r.Properties)
+	return effective
+}
+
+// ClearFormatting resets all run formatting to the defaults
+func (r *Run) ClearFormatting() *Run {
+	if r.Properties == nil {
+		r.Properties = properties.NewRunProperties()
+		return r
+	}
+	r.Properties.Reset()
+	return r
+}
+
+// SetColor sets the text color (hex such as "FF0000", "#F00" or a CSS name).
+// An invalid color leaves the color unchanged and is reported by Err.
 func (r *Run) SetColor(color string) *Run {
-	r.Properties.Color = color
+	normalized, err := properties.NormalizeColor(color)
+	if err != nil {
+		r.setErr(err)
+		return r
+	}
+	r.Properties.Color = normalized
+	return r
+}
+
+// SetColorNamed sets the text color from a CSS/Word color name (e.g., "navy", "lightblue").
+// An unknown name leaves the color unchanged and is reported by Err.
+func (r *Run) SetColorNamed(name string) *Run {
+	hex, ok := colors.Hex(name)
+	if !ok {
+		r.setErr(fmt.Errorf("unknown color name: %q", name))
+		return r
+	}
+	r.Properties.Color = hex
 	return r
 }
 
@@ -123,8 +201,31 @@ func (r *Run) SetHighlight(color string) *Run {
 	return r
 }
 
+// SetShading sets the run shading (character background)
+func (r *Run) SetShading(shading *properties.RunShading) *Run {
+	r.Properties.Shading = shading
+	return r
+}
+
+// HighlightHex highlights the run with an arbitrary hex color (e.g., "FFD966") using run shading.
+// An invalid color leaves the highlight unchanged and is reported by Err.
+func (r *Run) HighlightHex(hex string) *Run {
+	fill, err := properties.NormalizeColor(hex)
+	if err != nil {
+		r.setErr(err)
+		return r
+	}
+	r.Properties.Highlight = ""
+	r.Properties.Shading = &properties.RunShading{
+		Fill:    fill,
+		Color:   "auto",
+		Pattern: "clear",
+	}
+	return r
+}
+
 // SetVerticalAlign sets the vertical alignment
-// Values: "baseline", "superscript", "subscript"
+// Values: "baseline", "superscript", "subscript"; "baseline" overrides a style, "" inherits it
 func (r *Run) SetVerticalAlign(align string) *Run {
 	r.Properties.VerticalAlign = align
 	return r
@@ -142,6 +243,18 @@ func (r *Run) SetKerning(kerning float64) *Run {
 	return r
 }
 
+// SetCharacterScale sets the horizontal character scaling in percent (e.g., 150 for 150%)
+func (r *Run) SetCharacterScale(percent int) *Run {
+	r.Properties.Scale = percent
+	return r
+}
+
+// SetLanguage sets the proofing language of the run, overriding the document language
+func (r *Run) SetLanguage(lang string) *Run {
+	r.Properties.Language = lang
+	return r
+}
+
 // SetStyle sets the character style
 func (r *Run) SetStyle(styleID string) *Run {
 	r.Properties.StyleID = styleID
@@ -196,9 +309,36 @@ func (r *Run) SetVanish(vanish bool) *Run {
 	return r
 }
 
+// SetEmphasisMark sets the East Asian emphasis mark drawn with each character
+// Values: "dot" (above), "comma", "circle", "underDot" (below); "none" overrides a style, "" inherits it
+func (r *Run) SetEmphasisMark(style string) *Run {
+	r.Properties.EmphasisMark = style
+	return r
+}
+
+// SetLigatures sets which OpenType ligatures the font may use
+// Values: "none", "standard", "contextual", "historical", "discretional", combinations such as "standardContextual", or "all"
+func (r *Run) SetLigatures(mode string) *Run {
+	r.Properties.Ligatures = mode
+	return r
+}
+
+// SetNumberForm sets the OpenType digit form: "default", "lining" or "oldStyle"
+func (r *Run) SetNumberForm(form string) *Run {
+	r.Properties.NumberForm = form
+	return r
+}
+
+// SetNumberSpacing sets the OpenType digit spacing: "default", "proportional" or "tabular"
+func (r *Run) SetNumberSpacing(spacing string) *Run {
+	r.Properties.NumberSpacing = spacing
+	return r
+}
+
 // Clone creates a deep copy of the run
 func (r *Run) Clone() *Run {
 	newRun := &Run{
+		err:        r.err,
 		Properties: r.Properties.Clone(),
 		Children:   make([]RunChild, 0, len(r.Children)),
 	}
@@ -223,30 +363,88 @@ func (r *Run) Clone() *Run {
 	return newRun
 }
 
+// Text returns the plain text content of the run
+func (r *Run) Text() string {
+	var sb strings.Builder
+	for _, child := range r.Children {
+		switch c := child.(type) {
+		case *Text:
+			sb.WriteString(c.Value)
+		case *Tab:
+			sb.WriteString("\t")
+		case *LineBreak:
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
+
+// formattingKey returns a string that is equal for runs that serialize with the same formatting
+func (r *Run) formattingKey() string {
+	if r.Properties == nil || !r.HasFormatting() {
+		return ""
+	}
+	props, err := r.generatePropertiesXML()
+	if err != nil {
+		return fmt.Sprintf("%p", r) // Never matches another run
+	}
+	return props
+}
+
+// appendChildren appends run children, joining adjacent text elements
+func (r *Run) appendChildren(children []RunChild) {
+	for _, child := range children {
+		if t, ok := child.(*Text); ok && len(r.Children) > 0 {
+			if last, ok := r.Children[len(r.Children)-1].(*Text); ok {
+				r.Children[len(r.Children)-1] = NewText(last.Value + t.Value)
+				continue
+			}
+		}
+		r.Children = append(r.Children, child)
+	}
+}
+
 // HasFormatting returns true if the run has any formatting applied
 func (r *Run) HasFormatting() bool {
 	if r.Properties == nil {
 		return false
 	}
 
+	// Bold and italic count when explicitly off too: <w:b w:val="false"/> overrides a bold style
 	p := r.Properties
-	return (p.Bold != nil && *p.Bold) ||
-		(p.Italic != nil && *p.Italic) ||
+	return p.Bold != nil ||
+		p.Italic != nil ||
 		p.Underline != "" ||
 		(p.Strike != nil && *p.Strike) ||
+		(p.DoubleStrike != nil && *p.DoubleStrike) ||
+		(p.AllCaps != nil && *p.AllCaps) ||
+		(p.SmallCaps != nil && *p.SmallCaps) ||
+		(p.Outline != nil && *p.Outline) ||
+		(p.Shadow != nil && *p.Shadow) ||
+		(p.Emboss != nil && *p.Emboss) ||
+		(p.Imprint != nil && *p.Imprint) ||
+		(p.Vanish != nil && *p.Vanish) ||
+		p.Language != "" ||
 		p.FontSize != 0 ||
 		p.FontFamily != "" ||
 		p.Color != "" ||
 		p.Highlight != "" ||
 		p.VerticalAlign != "" ||
+		p.EmphasisMark != "" ||
+		p.Ligatures != "" ||
+		p.NumberForm != "" ||
+		p.NumberSpacing != "" ||
 		p.Spacing != 0 ||
 		p.Kerning != 0 ||
+		p.Scale != 0 ||
+		p.Shading != nil ||
 		p.StyleID != ""
 }
 
 // XML generates the XML representation of the run
 func (r *Run) XML() ([]byte, error) {
-	var buf bytes.Buffer
+	buf := getBuffer()
+	defer putBuffer(buf)
 
 	// Start run tag
 	buf.WriteString(`<w:r>`)
@@ -257,7 +455,7 @@ func (r *Run) XML() ([]byte, error) {
 		if err != nil {
 			return nil, fmt.Errorf("generating run properties XML: %w", err)
 		}
-		buf.Write(propXML)
+		buf.WriteString(propXML)
 	}
 
 	// Add children (text, breaks, tabs)
@@ -272,28 +470,33 @@ func (r *Run) XML() ([]byte, error) {
 	// Close run tag
 	buf.WriteString(`</w:r>`)
 
-	return buf.Bytes(), nil
+	return detachBytes(buf), nil
 }
 
 // generatePropertiesXML generates the run properties XML
-func (r *Run) generatePropertiesXML() ([]byte, error) {
-	rp := r.Properties
+func (r *Run) generatePropertiesXML() (string, error) {
+	return runPropertiesXML(r.Properties)
+}
+
+// runPropertiesXML generates <w:rPr> for run or paragraph mark properties
+func runPropertiesXML(rp *properties.RunProperties) (string, error) {
 	if rp == nil {
-		return nil, nil
+		return "", nil
 	}
 
-	var buf bytes.Buffer
+	var buf strings.Builder
+	buf.Grow(192)
 	buf.WriteString(`<w:rPr>`)
 
 	// Character style
 	if rp.StyleID != "" {
-		buf.WriteString(fmt.Sprintf(`<w:rStyle w:val="%s"/>`, rp.StyleID))
+		fmt.Fprintf(&buf, `<w:rStyle w:val="%s"/>`, rp.StyleID)
 	}
 
 	// Font family
 	if rp.FontFamily != "" {
-		buf.WriteString(fmt.Sprintf(`<w:rFonts w:ascii="%s" w:hAnsi="%s" w:eastAsia="%s" w:cs="%s"/>`,
-			rp.FontFamily, rp.FontFamily, rp.FontFamily, rp.FontFamily))
+		fmt.Fprintf(&buf, `<w:rFonts w:ascii="%s" w:hAnsi="%s" w:eastAsia="%s" w:cs="%s"/>`,
+			rp.FontFamily, rp.FontFamily, rp.FontFamily, rp.FontFamily)
 	}
 
 	// Bold
@@ -362,13 +565,13 @@ func (r *Run) generatePropertiesXML() ([]byte, error) {
 	if rp.FontSize > 0 {
 		// Convert points to half-points
 		halfPoints := int(rp.FontSize * 2)
-		buf.WriteString(fmt.Sprintf(`<w:sz w:val="%d"/>`, halfPoints))
-		buf.WriteString(fmt.Sprintf(`<w:szCs w:val="%d"/>`, halfPoints)) // Complex script size
+		fmt.Fprintf(&buf, `<w:sz w:val="%d"/>`, halfPoints)
+		fmt.Fprintf(&buf, `<w:szCs w:val="%d"/>`, halfPoints) // Complex script size
 	}
 
 	// Underline
 	if rp.Underline != "" && rp.Underline != "none" {
-		buf.WriteString(fmt.Sprintf(`<w:u w:val="%s"/>`, rp.Underline))
+		fmt.Fprintf(&buf, `<w:u w:val="%s"/>`, rp.Underline)
 	}
 
 	// Vanish/hidden
@@ -383,36 +586,91 @@ func (r *Run) generatePropertiesXML() ([]byte, error) {
 		if strings.HasPrefix(color, "#") {
 			color = color[1:]
 		}
-		buf.WriteString(fmt.Sprintf(`<w:color w:val="%s"/>`, color))
+		fmt.Fprintf(&buf, `<w:color w:val="%s"/>`, color)
 	}
 
 	// Character spacing
 	if rp.Spacing != 0 {
-		buf.WriteString(fmt.Sprintf(`<w:spacing w:val="%d"/>`, rp.Spacing))
+		fmt.Fprintf(&buf, `<w:spacing w:val="%d"/>`, rp.Spacing)
+	}
+
+	// Character scale
+	if rp.Scale > 0 {
+		fmt.Fprintf(&buf, `<w:w w:val="%d"/>`, rp.Scale)
 	}
 
 	// Kerning
 	if rp.Kerning > 0 {
-		buf.WriteString(fmt.Sprintf(`<w:kern w:val="%d"/>`, int(rp.Kerning*2))) // Convert to half-points
+		fmt.Fprintf(&buf, `<w:kern w:val="%d"/>`, int(rp.Kerning*2)) // Convert to half-points
 	}
 
 	// Highlight
 	if rp.Highlight != "" && rp.Highlight != "none" {
-		buf.WriteString(fmt.Sprintf(`<w:highlight w:val="%s"/>`, rp.Highlight))
+		fmt.Fprintf(&buf, `<w:highlight w:val="%s"/>`, rp.Highlight)
 	}
 
-	// Vertical alignment
-	if rp.VerticalAlign != "" && rp.VerticalAlign != "baseline" {
-		buf.WriteString(fmt.Sprintf(`<w:vertAlign w:val="%s"/>`, rp.VerticalAlign))
+	// Shading
+	if rp.Shading != nil {
+		pattern := rp.Shading.Pattern
+		if pattern == "" {
+			pattern = "clear"
+		}
+		fmt.Fprintf(&buf, `<w:shd w:val="%s"`, pattern)
+		if rp.Shading.Color != "" {
+			fmt.Fprintf(&buf, ` w:color="%s"`, strings.TrimPrefix(rp.Shading.Color, "#"))
+		}
+		if rp.Shading.Fill != "" {
+			fmt.Fprintf(&buf, ` w:fill="%s"`, strings.TrimPrefix(rp.Shading.Fill, "#"))
+		}
+		buf.WriteString(`/>`)
+	}
+
+	// Vertical alignment; an explicit "baseline" is written so it overrides a
+	// superscript or subscript inherited from a style
+	if rp.VerticalAlign != "" {
+		fmt.Fprintf(&buf, `<w:vertAlign w:val="%s"/>`, rp.VerticalAlign)
+	}
+
+	// Emphasis mark
+	if rp.EmphasisMark != "" {
+		if !properties.ValidEmphasisMark(rp.EmphasisMark) {
+			return "", fmt.Errorf("invalid emphasis mark: %s", rp.EmphasisMark)
+		}
+		fmt.Fprintf(&buf, `<w:em w:val="%s"/>`, rp.EmphasisMark)
+	}
+
+	// Language
+	if rp.Language != "" {
+		fmt.Fprintf(&buf, `<w:lang w:val="%s"/>`, rp.Language)
+	}
+
+	// OpenType typography; Word 2010 elements follow all w: elements
+	if rp.Ligatures != "" || rp.NumberForm != "" || rp.NumberSpacing != "" {
+		if err := rp.ValidateTypography(); err != nil {
+			return "", err
+		}
+		if rp.Ligatures != "" {
+			fmt.Fprintf(&buf, `<w14:ligatures w14:val="%s"/>`, rp.Ligatures)
+		}
+		if rp.NumberForm != "" {
+			fmt.Fprintf(&buf, `<w14:numForm w14:val="%s"/>`, rp.NumberForm)
+		}
+		if rp.NumberSpacing != "" {
+			fmt.Fprintf(&buf, `<w14:numSpacing w14:val="%s"/>`, rp.NumberSpacing)
+		}
 	}
 
 	buf.WriteString(`</w:rPr>`)
 
-	return buf.Bytes(), nil
+	return buf.String(), nil
 }
 
 // Validate checks if the run is valid
 func (r *Run) Validate() error {
+	if r.err != nil {
+		return r.err
+	}
+
 	if r.Properties != nil {
 		// Validate underline values
 		validUnderlines := map[string]bool{
@@ -443,6 +701,21 @@ func (r *Run) Validate() error {
 		if !validHighlights[r.Properties.Highlight] {
 			return fmt.Errorf("invalid highlight color: %s", r.Properties.Highlight)
 		}
+
+		// Validate character scale
+		if r.Properties.Scale < 0 || r.Properties.Scale > 600 {
+			return fmt.Errorf("invalid character scale: %d", r.Properties.Scale)
+		}
+
+		// Validate emphasis mark
+		if !properties.ValidEmphasisMark(r.Properties.EmphasisMark) {
+			return fmt.Errorf("invalid emphasis mark: %s", r.Properties.EmphasisMark)
+		}
+
+		// Validate typography
+		if err := r.Properties.ValidateTypography(); err != nil {
+			return err
+		}
 	}
 
 	return nil
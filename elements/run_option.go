@@ -0,0 +1,49 @@
+package elements
+
+// RunOption formats a run; options are applied in order by Paragraph.Append
+type RunOption func(*Run)
+
+// WithBold makes the run bold
+func WithBold() RunOption {
+	return func(r *Run) { r.SetBold(true) }
+}
+
+// WithItalic makes the run italic
+func WithItalic() RunOption {
+	return func(r *Run) { r.SetItalic(true) }
+}
+
+// WithUnderline underlines the run ("single", "double", "wave", ...)
+func WithUnderline(style string) RunOption {
+	return func(r *Run) { r.SetUnderline(style) }
+}
+
+// WithStrike strikes the run through
+func WithStrike() RunOption {
+	return func(r *Run) { r.SetStrike(true) }
+}
+
+// WithColor sets the text color (hex or CSS name)
+func WithColor(color string) RunOption {
+	return func(r *Run) { r.SetColor(color) }
+}
+
+// WithHighlight sets the highlight color ("yellow", "green", ...)
+func WithHighlight(color string) RunOption {
+	return func(r *Run) { r.SetHighlight(color) }
+}
+
+// WithFontSize sets the font size in points
+func WithFontSize(size float64) RunOption {
+	return func(r *Run) { r.SetFontSize(size) }
+}
+
+// WithFontFamily sets the font family
+func WithFontFamily(font string) RunOption {
+	return func(r *Run) { r.SetFontFamily(font) }
+}
+
+// WithStyle applies a character style
+func WithStyle(styleID string) RunOption {
+	return func(r *Run) { r.SetStyle(styleID) }
+}
@@ -0,0 +1,100 @@
+package elements
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Size of the signature line shape in points, the size Word gives a new signature line
+const (
+	signatureLineWidthPt  = 192
+	signatureLineHeightPt = 96
+)
+
+// SignatureLine is an Office signature line: a VML shape marked with
+// <o:signatureline> that Word shows as a line the recipient can sign, wrapped
+// in a content control. The document itself is not signed.
+type SignatureLine struct {
+	id          int64
+	SignatureID string // GUID identifying the signature line, in braces
+	Signer      string // Suggested signer name shown under the line
+	Title       string // Suggested signer title shown under the name
+	Picture     *Image // Picture shown until the line is signed; must be registered with the document
+}
+
+// NewSignatureLine creates a new signature line shown with picture, such as one made from SignatureLinePicture
+func NewSignatureLine(signer, title string, picture *Image) *SignatureLine {
+	return &SignatureLine{
+		id:          generateID(),
+		SignatureID: "{" + strings.ToUpper(uuid.NewString()) + "}",
+		Signer:      signer,
+		Title:       title,
+		Picture:     picture,
+	}
+}
+
+// SignatureLinePicture returns a PNG of an "X" above a rule, the placeholder
+// Word shows in an unsigned signature line. Word redraws it with the signer
+// name when the document is opened for signing.
+func SignatureLinePicture() []byte {
+	// 96 DPI, so the pixels map onto the shape size in points
+	w, h := signatureLineWidthPt*4/3, signatureLineHeightPt*4/3
+	img := image.NewPaletted(image.Rect(0, 0, w, h), color.Palette{color.White, color.Black})
+
+	ruleY := h * 2 / 3
+	for x := 8; x < w-8; x++ {
+		img.SetColorIndex(x, ruleY, 1)
+	}
+
+	// "X" resting on the rule
+	const size = 14
+	for i := 0; i <= size; i++ {
+		img.SetColorIndex(12+i, ruleY-6-size+i, 1)
+		img.SetColorIndex(12+size-i, ruleY-6-size+i, 1)
+	}
+
+	var buf bytes.Buffer
+	_ = png.Encode(&buf, img) // Encoding an in-memory paletted image cannot fail
+	return buf.Bytes()
+}
+
+// Type returns the element type
+func (s *SignatureLine) Type() string {
+	return "signatureLine"
+}
+
+// XML generates the content control holding the signature line shape
+func (s *SignatureLine) XML() ([]byte, error) {
+	if s.Picture == nil {
+		return nil, fmt.Errorf("signature line has no picture")
+	}
+
+	var buf bytes.Buffer
+
+	buf.WriteString(`<w:sdt><w:sdtPr>`)
+	buf.WriteString(`<w:alias w:val="Signature Line"/>`)
+	buf.WriteString(`<w:tag w:val="signature"/>`)
+	fmt.Fprintf(&buf, `<w:id w:val="%d"/>`, s.id)
+	buf.WriteString(`<w:lock w:val="sdtLocked"/>`)
+	buf.WriteString(`</w:sdtPr><w:sdtContent>`)
+
+	buf.WriteString(`<w:p><w:r>`)
+	buf.WriteString(`<w:pict xmlns:v="urn:schemas-microsoft-com:vml" xmlns:o="urn:schemas-microsoft-com:office:office">`)
+	fmt.Fprintf(&buf, `<v:shape id="SignatureLine%d" type="#_x0000_t75" alt="Microsoft Office Signature Line..." style="width:%dpt;height:%dpt">`,
+		s.id, signatureLineWidthPt, signatureLineHeightPt)
+	fmt.Fprintf(&buf, `<v:imagedata r:id="%s" o:title=""/>`, s.Picture.RelationshipID)
+	buf.WriteString(`<o:lock v:ext="edit" ungrouping="t" rotation="t" cropping="t" verticies="t" text="t" grouping="t"/>`)
+	fmt.Fprintf(&buf, `<o:signatureline v:ext="edit" id="%s" provid="{00000000-0000-0000-0000-000000000000}" o:suggestedsigner="%s" o:suggestedsigner2="%s" issignatureline="t"/>`,
+		s.SignatureID, escapeXMLAttribute(s.Signer), escapeXMLAttribute(s.Title))
+	buf.WriteString(`</v:shape></w:pict>`)
+	buf.WriteString(`</w:r></w:p>`)
+
+	buf.WriteString(`</w:sdtContent></w:sdt>`)
+	return buf.Bytes(), nil
+}
@@ -7,6 +7,14 @@ type Tab struct {
 	Leader    string // Leader character: "dot", "hyphen", "underscore", "heavy", "middleDot"
 }
 
+// TabSegment is a piece of text placed at a tab stop by Paragraph.AddTabbedText
+type TabSegment struct {
+	Text      string // Text of the segment
+	Alignment string // Tab stop alignment: "left", "center", "right", "decimal"; empty means "left"
+	Position  int    // Tab stop position in twips; 0 places the first segment at the margin
+	Leader    string // Optional leader filling the space before the text: "dot", "hyphen", "underscore"
+}
+
 // NewTab creates a new tab
 func NewTab() *Tab {
 	return &Tab{}
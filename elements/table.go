@@ -4,8 +4,13 @@ package elements
 import (
 	"bytes"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 
+	"github.com/didikprabowo/mbadocx/properties"
 	"github.com/didikprabowo/mbadocx/types"
+	"github.com/didikprabowo/mbadocx/units"
 )
 
 // Vertical alignment options
@@ -45,6 +50,22 @@ type TableProperties struct {
 	Look        *TableLook
 	CellSpacing *TableCellSpacing
 	Indent      *TableIndent
+	BiDiVisual  bool           // Lay out columns right to left
+	Position    *TablePosition // Floating position, nil for an inline table
+	Caption     string         // Accessibility caption (tblCaption)
+	Description string         // Accessibility description (tblDescription)
+}
+
+// TablePosition represents the position of a floating table (tblpPr)
+type TablePosition struct {
+	HorizontalAnchor string // margin, page, text
+	VerticalAnchor   string // margin, page, text
+	X                int    // Horizontal offset from the anchor in twips
+	Y                int    // Vertical offset from the anchor in twips
+	LeftFromText     int    // Distance from surrounding text in twips
+	RightFromText    int
+	TopFromText      int
+	BottomFromText   int
 }
 
 // TableWidth represents table width
@@ -104,6 +125,7 @@ type TableRowHeight struct {
 
 // TableCell represents a table cell
 type TableCell struct {
+	document   types.Document
 	Properties *TableCellProperties
 	Paragraphs []*Paragraph
 }
@@ -117,6 +139,8 @@ type TableCellProperties struct {
 	Shading       *TableCellShading
 	Margins       *TableCellMargins
 	VerticalAlign VerticalAlign // top, center, bottom
+	NoWrap        bool          // Do not wrap cell content
+	FitText       bool          // Fit text to the cell width
 }
 
 // TableCellWidth represents cell width
@@ -199,6 +223,14 @@ type TableCellShading struct {
 
 // NewTable creates a new table with specified rows and columns
 func NewTable(document types.Document, rows, cols int) *Table {
+	// Negative dimensions would make the slices below panic
+	if rows < 0 {
+		rows = 0
+	}
+	if cols < 0 {
+		cols = 0
+	}
+
 	table := &Table{
 		document: document,
 		Properties: &TableProperties{
@@ -255,6 +287,7 @@ func NewTable(document types.Document, rows, cols int) *Table {
 		}
 		for j := 0; j < cols; j++ {
 			row.Cells[j] = &TableCell{
+				document: document,
 				Properties: &TableCellProperties{
 					VerticalAlign: "center",
 					Width: &TableCellWidth{
@@ -263,7 +296,7 @@ func NewTable(document types.Document, rows, cols int) *Table {
 					},
 				},
 				Paragraphs: []*Paragraph{
-					NewParagraph(document), // Add empty paragraph to each cell
+					NewTableCellParagraph(document), // Add empty paragraph to each cell
 				},
 			}
 		}
@@ -292,32 +325,68 @@ func DefaultTableBorders() *TableBorders {
 
 // SetCellText sets text in a specific cell
 func (t *Table) SetCellText(row, col int, text string) error {
-	if row >= len(t.Rows) || col >= len(t.Rows[row].Cells) {
+	if row < 0 || col < 0 || row >= len(t.Rows) || col >= len(t.Rows[row].Cells) {
 		return fmt.Errorf("cell position out of bounds")
 	}
 
 	cell := t.Rows[row].Cells[col]
 	if len(cell.Paragraphs) == 0 {
-		cell.Paragraphs = []*Paragraph{NewParagraph(t.document)}
+		cell.Paragraphs = []*Paragraph{NewTableCellParagraph(t.document)}
 	}
 
 	// Clear existing content and add new text
 	cell.Paragraphs[0].Clear()
-	// Clear existing content and add formatted text
-	cell.Paragraphs[0].Clear()
-	cell.Paragraphs[0].Properties.SpacingBefore = 0
-	cell.Paragraphs[0].Properties.SpacingAfter = 0
-	cell.Paragraphs[0].Properties.LineSpacing = 1.15 // Default Word line spacing
-	cell.Paragraphs[0].Properties.LineSpacingRule = "auto"
+	cell.Paragraphs[0].applyCellSpacing()
 
 	cell.Paragraphs[0].AddText(text)
 
 	return nil
 }
 
+// GetCellText returns the plain text of a specific cell, joining its paragraphs with newlines
+func (t *Table) GetCellText(row, col int) (string, error) {
+	if row < 0 || col < 0 || row >= len(t.Rows) || col >= len(t.Rows[row].Cells) {
+		return "", fmt.Errorf("cell position out of bounds")
+	}
+
+	return t.Rows[row].Cells[col].Text(), nil
+}
+
+// ForEachCell calls fn for every cell, row by row, with its row and cell index
+func (t *Table) ForEachCell(fn func(row, col int, cell *TableCell)) {
+	for r, tr := range t.Rows {
+		for c, cell := range tr.Cells {
+			fn(r, c, cell)
+		}
+	}
+}
+
+// Text returns the plain text of the cell, one line per paragraph
+func (c *TableCell) Text() string {
+	texts := make([]string, 0, len(c.Paragraphs))
+	for _, p := range c.Paragraphs {
+		texts = append(texts, p.Text())
+	}
+	return strings.Join(texts, "\n")
+}
+
+// UsesNumbering reports whether any cell paragraph is a list item
+func (t *Table) UsesNumbering() bool {
+	for _, row := range t.Rows {
+		for _, cell := range row.Cells {
+			for _, p := range cell.Paragraphs {
+				if p.UsesNumbering() {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 // SetCellFormattedText sets formatted text in a specific cell
 func (t *Table) SetCellFormattedText(row, col int, text string, format func(*Run)) error {
-	if row >= len(t.Rows) || col >= len(t.Rows[row].Cells) {
+	if row < 0 || col < 0 || row >= len(t.Rows) || col >= len(t.Rows[row].Cells) {
 		return fmt.Errorf("cell position out of bounds")
 	}
 
@@ -328,10 +397,7 @@ func (t *Table) SetCellFormattedText(row, col int, text string, format func(*Run
 
 	// Clear existing content and add formatted text
 	cell.Paragraphs[0].Clear()
-	cell.Paragraphs[0].Properties.SpacingBefore = 0
-	cell.Paragraphs[0].Properties.SpacingAfter = 0
-	cell.Paragraphs[0].Properties.LineSpacing = 1.15 // Default Word line spacing
-	cell.Paragraphs[0].Properties.LineSpacingRule = "auto"
+	cell.Paragraphs[0].applyCellSpacing()
 
 	cell.Paragraphs[0].AddFormattedText(text, format)
 
@@ -340,6 +406,40 @@ func (t *Table) SetCellFormattedText(row, col int, text string, format func(*Run
 
 // AddRow adds a new row to the table
 func (t *Table) AddRow() *TableRow {
+	row := t.newRow()
+	t.Rows = append(t.Rows, row)
+	return row
+}
+
+// InsertRow inserts a new empty row at index, shifting later rows down.
+// An index outside the table is clamped to the first or last position.
+func (t *Table) InsertRow(index int) *TableRow {
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(t.Rows) {
+		return t.AddRow()
+	}
+
+	row := t.newRow()
+	t.Rows = append(t.Rows, nil)
+	copy(t.Rows[index+1:], t.Rows[index:])
+	t.Rows[index] = row
+	return row
+}
+
+// RemoveRow removes the row at index
+func (t *Table) RemoveRow(index int) error {
+	if index < 0 || index >= len(t.Rows) {
+		return fmt.Errorf("row index %d out of bounds", index)
+	}
+
+	t.Rows = append(t.Rows[:index], t.Rows[index+1:]...)
+	return nil
+}
+
+// newRow creates an empty row with one cell per grid column
+func (t *Table) newRow() *TableRow {
 	cols := len(t.Grid.Columns)
 	row := &TableRow{
 		Cells: make([]*TableCell, cols),
@@ -347,6 +447,7 @@ func (t *Table) AddRow() *TableRow {
 
 	for i := 0; i < cols; i++ {
 		row.Cells[i] = &TableCell{
+			document: t.document,
 			Properties: &TableCellProperties{
 				Width: &TableCellWidth{
 					Type:  "dxa",
@@ -359,13 +460,12 @@ func (t *Table) AddRow() *TableRow {
 		}
 	}
 
-	t.Rows = append(t.Rows, row)
 	return row
 }
 
 // SetColumnWidth sets the width of a specific column
 func (t *Table) SetColumnWidth(col int, width string) error {
-	if col >= len(t.Grid.Columns) {
+	if col < 0 || col >= len(t.Grid.Columns) {
 		return fmt.Errorf("column index out of bounds")
 	}
 
@@ -387,6 +487,100 @@ func (t *Table) SetColumnWidth(col int, width string) error {
 	return nil
 }
 
+// InsertColumn inserts an empty column of the given width (in twips) at index,
+// shifting later columns right. index may equal the column count to append.
+func (t *Table) InsertColumn(index int, width string) error {
+	if index < 0 || index > len(t.Grid.Columns) {
+		return fmt.Errorf("column index %d out of bounds", index)
+	}
+
+	t.Grid.Columns = append(t.Grid.Columns, nil)
+	copy(t.Grid.Columns[index+1:], t.Grid.Columns[index:])
+	t.Grid.Columns[index] = &TableGridCol{Width: width}
+
+	for _, row := range t.Rows {
+		cellIdx, offset := row.cellAtColumn(index)
+		if offset > 0 {
+			// The column falls inside a merged cell; widen it instead of splitting
+			row.Cells[cellIdx].Properties.GridSpan++
+			continue
+		}
+
+		cell := &TableCell{
+			document: t.document,
+			Properties: &TableCellProperties{
+				Width: &TableCellWidth{Type: "dxa", Value: width},
+			},
+			Paragraphs: []*Paragraph{NewTableCellParagraph(t.document)},
+		}
+		row.Cells = append(row.Cells, nil)
+		copy(row.Cells[cellIdx+1:], row.Cells[cellIdx:])
+		row.Cells[cellIdx] = cell
+	}
+
+	return nil
+}
+
+// RemoveColumn removes the column at index from the grid and every row.
+// Merged cells covering the column are narrowed by one column instead of removed.
+func (t *Table) RemoveColumn(index int) error {
+	if index < 0 || index >= len(t.Grid.Columns) {
+		return fmt.Errorf("column index %d out of bounds", index)
+	}
+	if len(t.Grid.Columns) == 1 {
+		return fmt.Errorf("cannot remove the only column of a table")
+	}
+
+	t.Grid.Columns = append(t.Grid.Columns[:index], t.Grid.Columns[index+1:]...)
+
+	for _, row := range t.Rows {
+		cellIdx, _ := row.cellAtColumn(index)
+		if cellIdx >= len(row.Cells) {
+			continue
+		}
+
+		cell := row.Cells[cellIdx]
+		if cell.Properties != nil && cell.Properties.GridSpan > 1 {
+			cell.Properties.GridSpan--
+			continue
+		}
+		row.Cells = append(row.Cells[:cellIdx], row.Cells[cellIdx+1:]...)
+	}
+
+	return nil
+}
+
+// cellAtColumn returns the index of the cell covering grid column col and the
+// column's offset inside that cell (non-zero for merged cells).
+// A column past the last cell returns len(r.Cells) and 0.
+func (r *TableRow) cellAtColumn(col int) (int, int) {
+	start := 0
+	for i, cell := range r.Cells {
+		span := 1
+		if cell.Properties != nil && cell.Properties.GridSpan > 1 {
+			span = cell.Properties.GridSpan
+		}
+		if col < start+span {
+			return i, col - start
+		}
+		start += span
+	}
+	return len(r.Cells), 0
+}
+
+// SetColumnWidthTwips sets the width of a specific column in twips (1440 = 1 inch)
+func (t *Table) SetColumnWidthTwips(col, twips int) error {
+	if twips < 0 {
+		return fmt.Errorf("column width cannot be negative: %d", twips)
+	}
+	return t.SetColumnWidth(col, strconv.Itoa(twips))
+}
+
+// SetColumnWidthLength sets the width of a specific column from a typed length (e.g., units.Inches(1.5))
+func (t *Table) SetColumnWidthLength(col int, width units.Length) error {
+	return t.SetColumnWidthTwips(col, int(width.Twips()))
+}
+
 // SetTableWidth sets the overall table width
 func (t *Table) SetTableWidth(widthType, value string) {
 	if t.Properties == nil {
@@ -399,6 +593,21 @@ func (t *Table) SetTableWidth(widthType, value string) {
 	t.Properties.Width.Value = value
 }
 
+// SetTableWidthTwips sets a fixed overall table width from a typed length
+func (t *Table) SetTableWidthTwips(width units.Length) {
+	t.SetTableWidth("dxa", strconv.Itoa(int(width.Twips())))
+}
+
+// SetTableWidthPct sets the overall table width as a percentage of the text width (e.g., 100 for full width)
+func (t *Table) SetTableWidthPct(pct float64) error {
+	if pct <= 0 || pct > 100 {
+		return fmt.Errorf("table width must be more than 0%% and at most 100%%, got %g%%", pct)
+	}
+	// Percentages are stored in fiftieths of a percent (5000 = 100%)
+	t.SetTableWidth("pct", strconv.Itoa(int(math.Round(pct*50))))
+	return nil
+}
+
 // SetTableAlignment sets table alignment (left, center, right)
 func (t *Table) SetTableAlignment(alignment TableAlign) {
 	if t.Properties == nil {
@@ -427,12 +636,17 @@ func (t *Table) MergeCells(row, startCol, endCol int) error {
 	return nil
 }
 
-// SetCellShading sets background color for a cell
+// SetCellShading sets background color for a cell; the color is normalized to 6-digit hex
 func (t *Table) SetCellShading(row, col int, color string) error {
-	if row >= len(t.Rows) || col >= len(t.Rows[row].Cells) {
+	if row < 0 || col < 0 || row >= len(t.Rows) || col >= len(t.Rows[row].Cells) {
 		return fmt.Errorf("cell position out of bounds")
 	}
 
+	fill, err := properties.NormalizeColor(color)
+	if err != nil {
+		return err
+	}
+
 	cell := t.Rows[row].Cells[col]
 	if cell.Properties == nil {
 		cell.Properties = &TableCellProperties{}
@@ -441,7 +655,7 @@ func (t *Table) SetCellShading(row, col int, color string) error {
 	cell.Properties.Shading = &TableCellShading{
 		Value: "clear",
 		Color: "auto",
-		Fill:  color,
+		Fill:  fill,
 	}
 
 	return nil
@@ -449,7 +663,7 @@ func (t *Table) SetCellShading(row, col int, color string) error {
 
 // SetCellVerticalAlignment sets vertical alignment for a cell
 func (t *Table) SetCellVerticalAlignment(row, col int, alignment VerticalAlign) error {
-	if row >= len(t.Rows) || col >= len(t.Rows[row].Cells) {
+	if row < 0 || col < 0 || row >= len(t.Rows) || col >= len(t.Rows[row].Cells) {
 		return fmt.Errorf("cell position out of bounds")
 	}
 
@@ -463,6 +677,107 @@ func (t *Table) SetCellVerticalAlignment(row, col int, alignment VerticalAlign)
 	return nil
 }
 
+// SetAccessibility sets the caption and description announced by screen readers
+func (t *Table) SetAccessibility(caption, description string) {
+	t.Properties.Caption = caption
+	t.Properties.Description = description
+}
+
+// SetRightToLeft sets whether the table columns are laid out from right to left
+func (t *Table) SetRightToLeft(rtl bool) {
+	t.Properties.BiDiVisual = rtl
+}
+
+// SetFloating makes the table float so body text wraps around it.
+// Anchors are "margin", "page" or "text"; offsets are in twips from the anchor.
+func (t *Table) SetFloating(hAnchor, vAnchor string, xOffset, yOffset int) error {
+	validAnchors := map[string]bool{"margin": true, "page": true, "text": true}
+	if !validAnchors[hAnchor] {
+		return fmt.Errorf("invalid horizontal anchor: %s", hAnchor)
+	}
+	if !validAnchors[vAnchor] {
+		return fmt.Errorf("invalid vertical anchor: %s", vAnchor)
+	}
+
+	t.Properties.Position = &TablePosition{
+		HorizontalAnchor: hAnchor,
+		VerticalAnchor:   vAnchor,
+		X:                xOffset,
+		Y:                yOffset,
+		LeftFromText:     180, // Word's default 0.125"
+		RightFromText:    180,
+	}
+	return nil
+}
+
+// SetInline removes floating positioning from the table
+func (t *Table) SetInline() {
+	t.Properties.Position = nil
+}
+
+// SetAllCellsShading sets the same background color on every cell of the table
+func (t *Table) SetAllCellsShading(color string) error {
+	fill, err := properties.NormalizeColor(color)
+	if err != nil {
+		return err
+	}
+
+	t.ForEachCell(func(_, _ int, cell *TableCell) {
+		if cell.Properties == nil {
+			cell.Properties = &TableCellProperties{}
+		}
+		cell.Properties.Shading = &TableCellShading{
+			Value: "clear",
+			Color: "auto",
+			Fill:  fill,
+		}
+	})
+
+	return nil
+}
+
+// SetAllCellsVerticalAlignment sets the same vertical alignment on every cell of the table
+func (t *Table) SetAllCellsVerticalAlignment(alignment VerticalAlign) {
+	t.ForEachCell(func(_, _ int, cell *TableCell) {
+		if cell.Properties == nil {
+			cell.Properties = &TableCellProperties{}
+		}
+		cell.Properties.VerticalAlign = alignment
+	})
+}
+
+// SetCellNoWrap sets whether a cell keeps its content on one line
+func (t *Table) SetCellNoWrap(row, col int, noWrap bool) error {
+	if row < 0 || col < 0 || row >= len(t.Rows) || col >= len(t.Rows[row].Cells) {
+		return fmt.Errorf("cell position out of bounds")
+	}
+
+	cell := t.Rows[row].Cells[col]
+	if cell.Properties == nil {
+		cell.Properties = &TableCellProperties{}
+	}
+
+	cell.Properties.NoWrap = noWrap
+
+	return nil
+}
+
+// SetCellFitText sets whether a cell's text is compressed or expanded to fit the cell width
+func (t *Table) SetCellFitText(row, col int, fit bool) error {
+	if row < 0 || col < 0 || row >= len(t.Rows) || col >= len(t.Rows[row].Cells) {
+		return fmt.Errorf("cell position out of bounds")
+	}
+
+	cell := t.Rows[row].Cells[col]
+	if cell.Properties == nil {
+		cell.Properties = &TableCellProperties{}
+	}
+
+	cell.Properties.FitText = fit
+
+	return nil
+}
+
 // SetRowHeight sets the height of a specific row
 func (t *Table) SetRowHeight(row int, height string, rule string) error {
 	if row >= len(t.Rows) {
@@ -498,7 +813,8 @@ func (t *Table) SetHeaderRow(row int) error {
 
 // XML generates the XML representation of the table
 func (t *Table) XML() ([]byte, error) {
-	var buf bytes.Buffer
+	buf := getBuffer()
+	defer putBuffer(buf)
 
 	buf.WriteString(`<w:tbl>`)
 
@@ -531,7 +847,7 @@ func (t *Table) XML() ([]byte, error) {
 
 	buf.WriteString(`</w:tbl>`)
 
-	return buf.Bytes(), nil
+	return detachBytes(buf), nil
 }
 
 // generatePropertiesXML generates the table properties XML
@@ -539,15 +855,23 @@ func (t *Table) generatePropertiesXML() ([]byte, error) {
 	var buf bytes.Buffer
 	buf.WriteString(`<w:tblPr>`)
 
-	if t.Properties.Indent != nil {
-		buf.WriteString(fmt.Sprintf(`<w:tblInd w:w="%s" w:type="%s"/>`, t.Properties.Indent.Width, t.Properties.Indent.Type))
-	}
-
 	// Table style
 	if t.Properties.Style != nil {
 		buf.WriteString(fmt.Sprintf(`<w:tblStyle w:val="%s"/>`, t.Properties.Style.Value))
 	}
 
+	// Floating position
+	if pos := t.Properties.Position; pos != nil {
+		fmt.Fprintf(&buf, `<w:tblpPr w:leftFromText="%d" w:rightFromText="%d" w:topFromText="%d" w:bottomFromText="%d" w:vertAnchor="%s" w:horzAnchor="%s" w:tblpX="%d" w:tblpY="%d"/>`,
+			pos.LeftFromText, pos.RightFromText, pos.TopFromText, pos.BottomFromText,
+			pos.VerticalAnchor, pos.HorizontalAnchor, pos.X, pos.Y)
+	}
+
+	// Right-to-left column order
+	if t.Properties.BiDiVisual {
+		buf.WriteString(`<w:bidiVisual/>`)
+	}
+
 	// Table width
 	if t.Properties.Width != nil {
 		buf.WriteString(fmt.Sprintf(`<w:tblW w:type="%s" w:w="%s"/>`,
@@ -559,6 +883,11 @@ func (t *Table) generatePropertiesXML() ([]byte, error) {
 		buf.WriteString(fmt.Sprintf(`<w:jc w:val="%s"/>`, t.Properties.Alignment.Value))
 	}
 
+	// Table indent, must follow jc in CT_TblPr
+	if t.Properties.Indent != nil {
+		buf.WriteString(fmt.Sprintf(`<w:tblInd w:w="%s" w:type="%s"/>`, t.Properties.Indent.Width, t.Properties.Indent.Type))
+	}
+
 	// Table borders
 	if t.Properties.Borders != nil {
 		bordersXML, err := t.generateBordersXML(t.Properties.Borders)
@@ -598,6 +927,14 @@ func (t *Table) generatePropertiesXML() ([]byte, error) {
 			t.Properties.Look.NoHBand, t.Properties.Look.NoVBand))
 	}
 
+	// Accessibility metadata, last in CT_TblPr
+	if t.Properties.Caption != "" {
+		fmt.Fprintf(&buf, `<w:tblCaption w:val="%s"/>`, escapeXMLAttribute(t.Properties.Caption))
+	}
+	if t.Properties.Description != "" {
+		fmt.Fprintf(&buf, `<w:tblDescription w:val="%s"/>`, escapeXMLAttribute(t.Properties.Description))
+	}
+
 	buf.WriteString(`</w:tblPr>`)
 	return buf.Bytes(), nil
 }
@@ -719,17 +1056,6 @@ func (t *Table) generateCellPropertiesXML(props *TableCellProperties) ([]byte, e
 		buf.WriteString(`/>`)
 	}
 
-	// Vertical alignment
-	// Vertical alignment - FIX: use "center" or "top", not "left"
-	if props.VerticalAlign != "" {
-		// Convert "left" to "top" for proper alignment
-		valign := props.VerticalAlign
-		if valign == "left" {
-			valign = "top"
-		}
-		buf.WriteString(fmt.Sprintf(`<w:vAlign w:val="%s"/>`, valign))
-	}
-
 	// Cell shading
 	if props.Shading != nil {
 		buf.WriteString(`<w:shd`)
@@ -745,6 +1071,11 @@ func (t *Table) generateCellPropertiesXML(props *TableCellProperties) ([]byte, e
 		buf.WriteString(`/>`)
 	}
 
+	// Keep the cell content on one line
+	if props.NoWrap {
+		buf.WriteString(`<w:noWrap/>`)
+	}
+
 	// Cell margins (padding)
 	if props.Margins != nil {
 		buf.WriteString(`<w:tcMar>`)
@@ -767,6 +1098,21 @@ func (t *Table) generateCellPropertiesXML(props *TableCellProperties) ([]byte, e
 		buf.WriteString(`</w:tcMar>`)
 	}
 
+	// Shrink or expand text to fit the cell width
+	if props.FitText {
+		buf.WriteString(`<w:tcFitText/>`)
+	}
+
+	// Vertical alignment, last in CT_TcPr - FIX: use "center" or "top", not "left"
+	if props.VerticalAlign != "" {
+		// Convert "left" to "top" for proper alignment
+		valign := props.VerticalAlign
+		if valign == "left" {
+			valign = "top"
+		}
+		buf.WriteString(fmt.Sprintf(`<w:vAlign w:val="%s"/>`, valign))
+	}
+
 	buf.WriteString(`</w:tcPr>`)
 	return buf.Bytes(), nil
 }
@@ -818,12 +1164,25 @@ func (t *Table) generateBorderXML(position string, border *BorderStyle) []byte {
 	return buf.Bytes()
 }
 
+// NewTableCellParagraph creates a paragraph with the tight spacing used inside table cells:
+// no space before or after and single line spacing (w:line="240")
 func NewTableCellParagraph(document types.Document) *Paragraph {
 	p := NewParagraph(document)
-	// Set explicit zero spacing for table cells
+	p.applyCellSpacing()
+	return p
+}
+
+// applyCellSpacing sets zero spacing around the paragraph and single line spacing
+// (w:line="240"), so cell text is not spaced out like body text
+func (p *Paragraph) applyCellSpacing() {
 	p.Properties.SpacingBefore = 0
 	p.Properties.SpacingAfter = 0
-	p.Properties.LineSpacing = 1.0 // Default Word line spacing
-	p.Properties.LineSpacingRule = "auto"
+	p.Properties.SetLineSpacingSingle()
+}
+
+// AddParagraph appends a new paragraph to the cell with table cell spacing
+func (c *TableCell) AddParagraph() *Paragraph {
+	p := NewTableCellParagraph(c.document)
+	c.Paragraphs = append(c.Paragraphs, p)
 	return p
 }
@@ -0,0 +1,28 @@
+package elements
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTableCellParagraphSpacing(t *testing.T) {
+	table := NewTable(nil, 1, 1)
+	cell := table.Rows[0].Cells[0]
+
+	paragraphs := []*Paragraph{cell.Paragraphs[0], cell.AddParagraph()}
+	for i, p := range paragraphs {
+		p.AddText("cell text")
+
+		xml, err := p.XML()
+		if err != nil {
+			t.Fatalf("paragraph %d: XML() error = %v", i, err)
+		}
+
+		got := string(xml)
+		for _, want := range []string{`w:line="240"`, `w:lineRule="auto"`, `w:before="0"`, `w:after="0"`} {
+			if !strings.Contains(got, want) {
+				t.Errorf("paragraph %d: XML missing %s\n%s", i, want, got)
+			}
+		}
+	}
+}
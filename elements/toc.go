@@ -0,0 +1,126 @@
+package elements
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/didikprabowo/mbadocx/types"
+)
+
+// TOCBookmarkPrefix starts the names of the bookmarks placed around headings for the table of contents
+const TOCBookmarkPrefix = "_Toc"
+
+var _ types.Element = (*TableOfContents)(nil)
+
+// TableOfContents is a TOC field listing the document headings. The entries are
+// generated when the document is written and link to the heading bookmarks;
+// Word replaces them, with page numbers, when the field is updated.
+type TableOfContents struct {
+	document  types.Document
+	MaxLevel  int    // Deepest heading level listed (1-9)
+	EmptyText string // Shown when the document has no headings
+}
+
+// NewTableOfContents creates a table of contents listing headings up to maxLevel
+func NewTableOfContents(document types.Document, maxLevel int) *TableOfContents {
+	if maxLevel < 1 || maxLevel > 9 {
+		maxLevel = 3
+	}
+	return &TableOfContents{
+		document:  document,
+		MaxLevel:  maxLevel,
+		EmptyText: "Right-click to update the table of contents.",
+	}
+}
+
+// Type returns the element type
+func (t *TableOfContents) Type() string {
+	return "tableOfContents"
+}
+
+// Includes reports whether the paragraph is a heading listed in the table of contents
+func (t *TableOfContents) Includes(p *Paragraph) bool {
+	if p == nil {
+		return false
+	}
+	level := p.HeadingLevel()
+	return level >= 1 && level <= t.MaxLevel
+}
+
+// Instruction returns the TOC field instruction
+func (t *TableOfContents) Instruction() string {
+	return fmt.Sprintf(` TOC \o "1-%d" \h \z \u `, t.MaxLevel)
+}
+
+// XML generates the field with one hyperlinked entry per heading in the body
+func (t *TableOfContents) XML() ([]byte, error) {
+	var entries []*Paragraph
+	if t.document != nil {
+		for _, el := range t.document.Body().GetElements() {
+			heading, ok := el.(*Paragraph)
+			if !ok || !t.Includes(heading) {
+				continue
+			}
+
+			entry := NewParagraph(t.document)
+			entry.SetSpacing(0, 5)
+			entry.SetIndentation(float64(heading.HeadingLevel()-1)*11, 0, 0)
+
+			text := heading.Text()
+			if anchor := heading.BookmarkWithPrefix(TOCBookmarkPrefix); anchor != "" {
+				link := NewBookmarkHyperlink("", anchor)
+				r := NewRun()
+				r.AddText(text)
+				link.Children = append(link.Children, r)
+				entry.AddChildren(link)
+			} else {
+				entry.AddText(text)
+			}
+			entries = append(entries, entry)
+		}
+	}
+
+	if len(entries) == 0 {
+		empty := NewParagraph(t.document)
+		empty.AddText(t.EmptyText)
+		entries = append(entries, empty)
+	}
+
+	// The field begins in the first entry and ends in the last one
+	first := entries[0]
+	begin := make([]ParagraphChild, 0, len(first.Children)+3)
+	begin = append(begin, newFieldRun(&FieldChar{CharType: FieldCharBegin, Dirty: true}))
+	begin = append(begin, newFieldRun(&InstrText{Text: t.Instruction()}))
+	begin = append(begin, newFieldRun(&FieldChar{CharType: FieldCharSeparate}))
+	first.Children = append(begin, first.Children...)
+	entries[len(entries)-1].AddChildren(newFieldRun(&FieldChar{CharType: FieldCharEnd}))
+
+	var buf bytes.Buffer
+	for _, entry := range entries {
+		xml, err := entry.XML()
+		if err != nil {
+			return nil, fmt.Errorf("generating table of contents entry: %w", err)
+		}
+		buf.Write(xml)
+	}
+	return buf.Bytes(), nil
+}
+
+// HeadingLevel returns the heading level from a HeadingN style or the outline level, or 0 for body text
+// and for paragraphs without properties
+func (p *Paragraph) HeadingLevel() int {
+	if p.Properties == nil {
+		return 0
+	}
+	if strings.HasPrefix(p.Properties.StyleID, "Heading") {
+		if level, err := strconv.Atoi(strings.TrimPrefix(p.Properties.StyleID, "Heading")); err == nil && level >= 1 && level <= 9 {
+			return level
+		}
+	}
+	if p.Properties.OutlineLevel != nil {
+		return *p.Properties.OutlineLevel + 1
+	}
+	return 0
+}
@@ -0,0 +1,118 @@
+package elements
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+)
+
+// buildChartWorkbook generates a minimal XLSX workbook holding the chart data.
+// Word opens this embedded workbook when the user chooses "Edit Data".
+//
+// Layout: column A holds the categories, every following column holds one
+// series with its name in row 1.
+func buildChartWorkbook(categories []string, series []ChartSeries) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", xml.Header +
+			`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+			`</Types>`},
+		{"_rels/.rels", xml.Header +
+			`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`},
+		{"xl/workbook.xml", xml.Header +
+			`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets>` +
+			`</workbook>`},
+		{"xl/_rels/workbook.xml.rels", xml.Header +
+			`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`</Relationships>`},
+		{"xl/worksheets/sheet1.xml", buildChartSheet(categories, series)},
+	}
+
+	for _, part := range parts {
+		w, err := zw.Create(part.name)
+		if err != nil {
+			return nil, fmt.Errorf("creating workbook part %s: %w", part.name, err)
+		}
+		if _, err := w.Write([]byte(part.content)); err != nil {
+			return nil, fmt.Errorf("writing workbook part %s: %w", part.name, err)
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("closing workbook: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// buildChartSheet generates the worksheet XML for the chart data
+func buildChartSheet(categories []string, series []ChartSeries) string {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	buf.WriteString(`<sheetData>`)
+
+	// Header row: empty corner cell followed by series names
+	buf.WriteString(`<row r="1">`)
+	for i, s := range series {
+		writeInlineStringCell(&buf, cellRef(i+1, 1), s.Name)
+	}
+	buf.WriteString(`</row>`)
+
+	// Data rows: category followed by each series value
+	for row, category := range categories {
+		buf.WriteString(fmt.Sprintf(`<row r="%d">`, row+2))
+		writeInlineStringCell(&buf, cellRef(0, row+2), category)
+		for i, s := range series {
+			buf.WriteString(fmt.Sprintf(`<c r="%s"><v>%s</v></c>`,
+				cellRef(i+1, row+2), formatChartValue(s.Values[row])))
+		}
+		buf.WriteString(`</row>`)
+	}
+
+	buf.WriteString(`</sheetData>`)
+	buf.WriteString(`</worksheet>`)
+	return buf.String()
+}
+
+// writeInlineStringCell writes a cell holding an inline string
+func writeInlineStringCell(buf *bytes.Buffer, ref, value string) {
+	buf.WriteString(fmt.Sprintf(`<c r="%s" t="inlineStr"><is><t>`, ref))
+	_ = xml.EscapeText(buf, []byte(value))
+	buf.WriteString(`</t></is></c>`)
+}
+
+// columnName converts a zero-based column index to a spreadsheet column name (A, B, ..., AA)
+func columnName(col int) string {
+	name := ""
+	for col >= 0 {
+		name = string(rune('A'+col%26)) + name
+		col = col/26 - 1
+	}
+	return name
+}
+
+// cellRef returns a cell reference such as "B3" for a zero-based column and one-based row
+func cellRef(col, row int) string {
+	return columnName(col) + strconv.Itoa(row)
+}
+
+// formatChartValue formats a numeric chart value without trailing zeros
+func formatChartValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -75,7 +75,7 @@ func main() {
 			cell := table2.Rows[0].Cells[i]
 			if len(cell.Paragraphs) > 0 {
 				// Clear and re-add with formatting
-				text := getTextFromCell(cell)
+				text := cell.Text()
 				cell.Paragraphs[0].Clear()
 				cell.Paragraphs[0].AddFormattedText(text, func(r *elements.Run) {
 					r.SetBold(true)
@@ -150,7 +150,7 @@ func main() {
 	for i := 0; i < 4; i++ {
 		cell := table4.Rows[1].Cells[i]
 		if len(cell.Paragraphs) > 0 {
-			text := getTextFromCell(cell)
+			text := cell.Text()
 			cell.Paragraphs[0].Clear()
 			cell.Paragraphs[0].AddFormattedText(text, func(r *elements.Run) {
 				r.SetBold(true)
@@ -176,7 +176,7 @@ func main() {
 		_ = table4.SetCellShading(4, j, "E0E0E0")
 		cell := table4.Rows[4].Cells[j]
 		if len(cell.Paragraphs) > 0 {
-			text := getTextFromCell(cell)
+			text := cell.Text()
 			cell.Paragraphs[0].Clear()
 			cell.Paragraphs[0].AddFormattedText(text, func(r *elements.Run) {
 				r.SetBold(true)
@@ -248,15 +248,3 @@ func main() {
 		log.Fatalf("Failed to save document: %v", err)
 	}
 }
-
-// Helper function to get text from a cell
-func getTextFromCell(cell *elements.TableCell) string {
-	if len(cell.Paragraphs) > 0 && len(cell.Paragraphs[0].Children) > 0 {
-		if run, ok := cell.Paragraphs[0].Children[0].(*elements.Run); ok {
-			if text, ok := run.Children[0].(*elements.Text); ok {
-				return text.Value
-			}
-		}
-	}
-	return ""
-}
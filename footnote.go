@@ -0,0 +1,139 @@
+package mbadocx
+
+import (
+	"fmt"
+
+	"github.com/didikprabowo/mbadocx/elements"
+	"github.com/didikprabowo/mbadocx/properties"
+)
+
+// AddFootnote adds a footnote to a paragraph.
+//
+// A superscript reference mark is appended to the paragraph and the note text is
+// stored in word/footnotes.xml, which is created with the first footnote. Word
+// numbers the marks when the document is opened, following the format set with
+// SetFootnoteNumbering.
+//
+// Parameters:
+//   - p: The paragraph that receives the reference mark
+//   - text: The footnote text
+//
+// Returns:
+//   - *elements.Footnote: The footnote; further runs can be added to its Paragraph
+//   - error: An error if the document has been closed or p is nil
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	p := doc.AddParagraph()
+//	p.AddText("Go was announced in 2009.")
+//	if _, err := doc.AddFootnote(p, "The Go Programming Language, go.dev"); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) AddFootnote(p *elements.Paragraph, text string) (*elements.Footnote, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return nil, fmt.Errorf("document has been closed")
+	}
+	if p == nil {
+		return nil, fmt.Errorf("paragraph must not be nil")
+	}
+
+	if d.footnotes == nil {
+		d.footnotes = elements.NewFootnotes()
+		rel := d.relationships.AddFootnotes(d.footnotes.FileName())
+		d.footnotes.RelationshipID = rel.ID
+		d.contentTypes.AddOverride("/"+d.footnotes.TargetPath()+d.footnotes.FileName(), elements.ContentTypeFootnotes)
+		d.media.AddMedia(d.footnotes)
+	}
+
+	note := d.footnotes.Add(d, text)
+	p.AddFootnoteReference(note)
+	return note, nil
+}
+
+// SetFootnoteNumbering sets how footnote reference marks are numbered.
+//
+// The settings are written to <w:footnotePr> of the current section, so footnotes
+// configured for "lowerRoman" appear as i, ii, iii.
+//
+// Parameters:
+//   - format: "decimal", "lowerRoman", "upperRoman", "lowerLetter", "upperLetter" or "chicago" (*, †, ‡)
+//   - restart: "continuous", "eachSect" or "eachPage"; empty keeps Word's default (continuous)
+//
+// Returns:
+//   - error: An error if the document has been closed or a value is not recognised
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	if err := doc.SetFootnoteNumbering("lowerRoman", "eachPage"); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) SetFootnoteNumbering(format, restart string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	settings := properties.FootnoteSettings{}
+	if d.section.Footnotes != nil {
+		settings = *d.section.Footnotes
+	}
+	settings.NumberFormat = format
+	settings.RestartRule = restart
+
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	d.section.Footnotes = &settings
+	return nil
+}
+
+// SetFootnotePlacement sets where the footnotes of the current section are
+// placed and the number of the first footnote.
+//
+// The settings are written to <w:footnotePr> of the current section, so
+// after AddSectionBreak each section can place and number its footnotes
+// differently.
+//
+// Parameters:
+//   - position: "pageBottom" or "beneathText"; empty keeps Word's default (pageBottom)
+//   - startAt: Number of the first footnote; 0 keeps Word's default (1)
+//
+// Returns:
+//   - error: An error if the document has been closed or a value is not recognised
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	if err := doc.SetFootnotePlacement("beneathText", 1); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) SetFootnotePlacement(position string, startAt int) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	settings := properties.FootnoteSettings{}
+	if d.section.Footnotes != nil {
+		settings = *d.section.Footnotes
+	}
+	settings.Position = position
+	settings.StartAt = startAt
+
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	d.section.Footnotes = &settings
+	return nil
+}
@@ -0,0 +1,100 @@
+package mbadocx
+
+import (
+	"fmt"
+
+	"github.com/didikprabowo/mbadocx/elements"
+	"github.com/didikprabowo/mbadocx/relationships"
+)
+
+// AddHeader adds a header to the current section and returns it for content.
+//
+// The header is stored in its own word/headerN.xml part and referenced from
+// the section with <w:headerReference>. A "default" header appears on every
+// page of the section; a "first" header replaces it on the first page when
+// SetDifferentFirstPage is enabled. Adding a header of the same kind again
+// replaces the previous one in the current section. Sections started later
+// with AddSectionBreak keep the header until they are given their own.
+//
+// Parameters:
+//   - kind: "default" (empty means default) or "first"
+//
+// Returns:
+//   - *elements.HeaderFooter: The header; add paragraphs with AddParagraph
+//   - error: An error if the document has been closed or the kind is not recognised
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	header, err := doc.AddHeader("default")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	header.AddParagraph().AddText("Annual Report 2026")
+func (d *Document) AddHeader(kind string) (*elements.HeaderFooter, error) {
+	return d.addHeaderFooter(kind, false)
+}
+
+// AddFooter adds a footer to the current section and returns it for content.
+//
+// The footer works like AddHeader, stored in word/footerN.xml and referenced
+// with <w:footerReference>. With Paragraph.AddPageNumberField it shows the
+// page numbers set with SetPageNumbering.
+//
+// Parameters:
+//   - kind: "default" (empty means default) or "first"
+//
+// Returns:
+//   - *elements.HeaderFooter: The footer; add paragraphs with AddParagraph
+//   - error: An error if the document has been closed or the kind is not recognised
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	footer, err := doc.AddFooter("default")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	footer.AddParagraph().SetAlignment(elements.AlignmentCenter).AddPageNumberField()
+func (d *Document) AddFooter(kind string) (*elements.HeaderFooter, error) {
+	return d.addHeaderFooter(kind, true)
+}
+
+// addHeaderFooter creates a header or footer part and links it to the current section
+func (d *Document) addHeaderFooter(kind string, footer bool) (*elements.HeaderFooter, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return nil, fmt.Errorf("document has been closed")
+	}
+
+	switch kind {
+	case "":
+		kind = "default"
+	case "default", "first":
+	default:
+		return nil, fmt.Errorf("invalid header/footer kind %q (supported: default, first)", kind)
+	}
+
+	var part *elements.HeaderFooter
+	var err error
+	if footer {
+		part = elements.NewFooter(d, d.relationships.CountByType(relationships.TypeFooter)+1)
+		rel := d.relationships.AddFooter(part.FileName())
+		part.RelationshipID = rel.ID
+		err = d.section.SetFooter(kind, rel.ID)
+	} else {
+		part = elements.NewHeader(d, d.relationships.CountByType(relationships.TypeHeader)+1)
+		rel := d.relationships.AddHeader(part.FileName())
+		part.RelationshipID = rel.ID
+		err = d.section.SetHeader(kind, rel.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	d.contentTypes.AddOverride("/"+part.TargetPath()+part.FileName(), part.ContentType())
+	d.media.AddMedia(part)
+	return part, nil
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 
 	"github.com/didikprabowo/mbadocx/elements"
+	"github.com/didikprabowo/mbadocx/writer"
 )
 
-// AddHeading adds a heading paragraph
+// AddHeading adds a heading paragraph using the Heading1-Heading9 styles; out-of-range levels fall back to 1.
+// When a table of contents lists the level, the heading gets a bookmark the TOC entry links to.
 func (d *Document) AddHeading(text string, level int) *elements.Paragraph {
 	if level < 1 || level > 9 {
 		level = 1
@@ -19,5 +21,44 @@ func (d *Document) AddHeading(text string, level int) *elements.Paragraph {
 		p.AddText(text)
 	}
 
+	d.mu.Lock()
+	if d.toc != nil && d.toc.Includes(p) {
+		d.bookmarkHeading(p)
+	}
+	d.mu.Unlock()
+
 	return p
 }
+
+// EnableHeadingNumbering numbers the headings of the document hierarchically.
+//
+// The Heading1-Heading9 styles are linked to a multilevel list, so every
+// heading added with AddHeading is numbered by Word according to its level:
+// "1", "1.1", "1.1.1" and so on. A Heading1 restarts the numbering of the
+// levels below it.
+//
+// Returns:
+//   - error: An error if the document has been closed
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	doc.EnableHeadingNumbering()
+//	doc.AddHeading("Introduction", 1) // 1 Introduction
+//	doc.AddHeading("Scope", 2)        // 1.1 Scope
+//	doc.AddHeading("Background", 1)   // 2 Background
+func (d *Document) EnableHeadingNumbering() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	for level := 1; level <= 9; level++ {
+		if err := d.styles.SetNumbering(fmt.Sprintf("Heading%d", level), writer.HeadingNumID, level-1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package mbadocx
 
 import (
+	"fmt"
+
 	"github.com/didikprabowo/mbadocx/elements"
 )
 
@@ -20,7 +22,7 @@ import (
 // Returns:
 //   - *elements.Image: A pointer to the created Image element that can be used
 //     to set properties like width, height, alignment, etc.
-//   - error: An error if the image file cannot be read, is in an unsupported format,
+//   - error: An error if the document has been closed, the image file cannot be read, is in an unsupported format,
 //     or if there are issues creating the image element
 //
 // Example:
@@ -35,18 +37,34 @@ import (
 //
 //	// Optionally configure the image after adding
 //	img.SetWidth(300).SetHeight(200)
-//	img.SetAlignment(elements.AlignCenter)
+//	img.SetAlignment(properties.AlignCenter)
 //
 // Note: The image is embedded in the DOCX file, so the original image file
 // is not needed once the document is saved. The image data is stored in the
 // document's media collection and referenced via relationships.
 //
 // Common error scenarios:
-//   - File not found at the specified path
-//   - Insufficient permissions to read the file
-//   - Unsupported or corrupted image format
+//   - File not found at the specified path (errors.Is(err, fs.ErrNotExist))
+//   - Insufficient permissions to read the file (errors.Is(err, fs.ErrPermission))
+//   - Unsupported image format (errors.Is(err, elements.ErrUnsupportedImageFormat))
+//   - Corrupted image data (errors.Is(err, elements.ErrInvalidImage)); errors.As with
+//     *elements.ImageError gives the decoder error
 //   - Out of memory for very large images
+//
+// Error messages include the file path and the detected image type.
 func (d *Document) AddImage(imagePath string) (*elements.Image, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return nil, fmt.Errorf("document has been closed")
+	}
+
+	return d.addImage(imagePath)
+}
+
+// addImage adds an image in its own paragraph (must be called with lock held).
+func (d *Document) addImage(imagePath string) (*elements.Image, error) {
 	// Create a new Image element from the file path
 	// This validates the file exists and reads its contents
 	img, err := elements.NewImage(d, imagePath)
@@ -74,3 +92,208 @@ func (d *Document) AddImage(imagePath string) (*elements.Image, error) {
 	// Return the image element for optional further configuration
 	return img, nil
 }
+
+// AddImageStream inserts an image like AddImage, but streams the file into the
+// package when the document is saved instead of holding it in memory.
+//
+// Only the image header is decoded and the file is hashed once for
+// deduplication, so embedding very large images does not double memory use
+// during Save. The file must stay in place and unchanged until the document
+// has been saved.
+//
+// Parameters:
+//   - imagePath: The file system path to the image file (JPEG, PNG, GIF, BMP, TIFF)
+//
+// Returns:
+//   - *elements.Image: The created image for sizing and positioning
+//   - error: An error if the document has been closed, or the file cannot be read or is not a supported image
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	img, err := doc.AddImageStream("./renders/poster-8k.png")
+//	if err != nil {
+//	    log.Fatalf("Failed to add image: %v", err)
+//	}
+//	img.ScaleToWidth(6)
+func (d *Document) AddImageStream(imagePath string) (*elements.Image, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return nil, fmt.Errorf("document has been closed")
+	}
+
+	img, err := elements.NewImageStream(d, imagePath)
+	if err != nil {
+		return nil, err
+	}
+
+	p := elements.NewParagraph(d)
+	p.AddChildren(img)
+	d.body.AddElement(p)
+	d.media.AddMedia(img)
+
+	return img, nil
+}
+
+// AddLinkedImage inserts an image that is loaded from a remote URL when the
+// document is opened instead of being embedded in the package.
+//
+// The image uses an external relationship (TargetMode="External") and a blip
+// with r:link, so no image bytes are stored in the file. Because the image is
+// not downloaded, its display size must be given. Word may ask before fetching
+// linked content and shows a placeholder when the URL is unreachable.
+//
+// Parameters:
+//   - url: Absolute URL of the image (e.g., "https://example.com/logo.png")
+//   - widthIn: Display width in inches
+//   - heightIn: Display height in inches
+//
+// Returns:
+//   - *elements.Image: The linked image for positioning and alt text
+//   - error: An error if the document has been closed, the URL is not absolute or the size is not positive
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	img, err := doc.AddLinkedImage("https://example.com/chart.png", 4, 3)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	img.SetAltText("Monthly sales chart")
+func (d *Document) AddLinkedImage(url string, widthIn, heightIn float64) (*elements.Image, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return nil, fmt.Errorf("document has been closed")
+	}
+
+	img, err := elements.NewLinkedImage(d, url, widthIn, heightIn)
+	if err != nil {
+		return nil, err
+	}
+
+	// Linked images have no media part to write
+	p := elements.NewParagraph(d)
+	p.AddChildren(img)
+	d.body.AddElement(p)
+
+	return img, nil
+}
+
+// AddImageWith inserts an image like AddImage and configures it with options
+// in a single call.
+//
+// Options are applied in order, so a later size option overrides an earlier
+// one. An alignment option also aligns the paragraph that holds the image.
+//
+// Parameters:
+//   - imagePath: The file system path to the image file
+//   - opts: Image options such as elements.WithSizeInches, elements.WithAlignment
+//     and elements.WithBorder
+//
+// Returns:
+//   - *elements.Image: The created and configured image
+//   - error: An error if the document has been closed, the image cannot be loaded
+//     (see AddImage) or an option is invalid, such as a bad border color
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	_, err := doc.AddImageWith("./assets/logo.png",
+//	    elements.WithSizeInches(2, 1),
+//	    elements.WithAlignment(properties.AlignCenter),
+//	    elements.WithBorder(2, "1F4E79"),
+//	)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) AddImageWith(imagePath string, opts ...elements.ImageOption) (*elements.Image, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return nil, fmt.Errorf("document has been closed")
+	}
+
+	before := d.relationships.Count()
+	img, err := elements.NewImage(d, imagePath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, opt := range opts {
+		opt(img)
+	}
+
+	p := elements.NewParagraph(d)
+	err = img.Err()
+	if align := img.Alignment(); align != "" && err == nil {
+		var alignment elements.ParagraphAlignment
+		if alignment, err = elements.ParseParagraphAlignment(string(align)); err == nil {
+			p.SetAlignment(alignment)
+		}
+	}
+	if err != nil {
+		// Drop the relationship NewImage created, unless it is shared with an earlier copy of the image
+		if d.relationships.Count() > before {
+			d.relationships.Remove(img.RelationshipID)
+		}
+		return nil, err
+	}
+	p.AddChildren(img)
+	d.body.AddElement(p)
+	d.media.AddMedia(img)
+
+	return img, nil
+}
+
+// AddImages inserts several images, each in its own paragraph, scaled to a
+// common width while keeping their aspect ratio.
+//
+// Parameters:
+//   - paths: File system paths of the images, inserted in order
+//   - widthIn: Target width of every image in inches
+//
+// Returns:
+//   - []*elements.Image: The created images, in the same order as paths
+//   - error: An error if the document has been closed, or an error naming the
+//     first path that could not be loaded. Images
+//     before that path have already been added and are returned.
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//
+//	paths, _ := filepath.Glob("./screenshots/*.png")
+//	if _, err := doc.AddImages(paths, 6); err != nil {
+//	    log.Fatalf("Failed to add screenshots: %v", err)
+//	}
+func (d *Document) AddImages(paths []string, widthIn float64) ([]*elements.Image, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return nil, fmt.Errorf("document has been closed")
+	}
+
+	if widthIn <= 0 {
+		return nil, fmt.Errorf("image width must be positive: %g", widthIn)
+	}
+
+	images := make([]*elements.Image, 0, len(paths))
+	for _, path := range paths {
+		img, err := d.addImage(path)
+		if err != nil {
+			return images, fmt.Errorf("add image %s: %w", path, err)
+		}
+
+		// Scale to the shared width, keeping the aspect ratio
+		img.ScaleToWidth(widthIn)
+		images = append(images, img)
+	}
+
+	return images, nil
+}
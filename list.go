@@ -1,6 +1,11 @@
 package mbadocx
 
-import "github.com/didikprabowo/mbadocx/elements"
+import (
+	"fmt"
+
+	"github.com/didikprabowo/mbadocx/elements"
+	"github.com/didikprabowo/mbadocx/writer"
+)
 
 // addList is a private helper method that handles creation of all list types.
 // It provides a single implementation for adding lists to avoid code duplication
@@ -161,3 +166,73 @@ func (d *Document) AddLegalList(items []string, lvl int) *elements.Paragraph {
 func (d *Document) AddRomanList(items []string, lvl int) *elements.Paragraph {
 	return d.addList(items, elements.ListTypeRoman, lvl)
 }
+
+// DefineList defines a numbered list with its own number formats and level
+// text, and returns the numbering ID that paragraphs use to join the list.
+//
+// The level text can hold placeholders %1 to %9 for the current number of
+// levels 1 to 9, so "Article %1 -" numbers paragraphs as "Article 1 -",
+// "Article 2 -", and "%1.%2" on the second level numbers them as "1.1",
+// "1.2". A level may only refer to itself and the levels above it. Levels are
+// taken in order, starting at level 0; an unset start, justification, suffix
+// or indentation gets the same defaults as the built-in lists.
+//
+// Parameters:
+//   - levels: One definition per level (at most 9), e.g. {NumFormat: "decimal", LevelText: "Article %1 -"}
+//
+// Returns:
+//   - int: The numbering ID to pass to Paragraph.SetNumberingID
+//   - error: An error if the document has been closed or a level is invalid
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	articles, err := doc.DefineList(
+//	    writer.Level{NumFormat: "decimal", LevelText: "Article %1 -"},
+//	    writer.Level{NumFormat: "decimal", LevelText: "%1.%2"},
+//	)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	doc.AddParagraph().SetNumberingID(articles, 0).AddText("Definitions")    // Article 1 - Definitions
+//	doc.AddParagraph().SetNumberingID(articles, 1).AddText("Interpretation") // 1.1 Interpretation
+//	doc.AddParagraph().SetNumberingID(articles, 0).AddText("Term")           // Article 2 - Term
+func (d *Document) DefineList(levels ...writer.Level) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return 0, fmt.Errorf("document has been closed")
+	}
+	if len(levels) == 0 || len(levels) > 9 {
+		return 0, fmt.Errorf("a list must have between 1 and 9 levels, got %d", len(levels))
+	}
+
+	list := writer.AbstractNum{
+		MultiLevel: len(levels) > 1,
+		Name:       fmt.Sprintf("Custom List %d", len(d.lists)+1),
+	}
+	for i, lvl := range levels {
+		lvl.Level = i
+		if lvl.Start == 0 {
+			lvl.Start = 1
+		}
+		if lvl.LevelJc == "" {
+			lvl.LevelJc = "left"
+		}
+		if lvl.Suffix == "" {
+			lvl.Suffix = "tab"
+		}
+		if lvl.IndentLeft == 0 && lvl.IndentHanging == 0 {
+			lvl.IndentLeft = 720 * (i + 1)
+			lvl.IndentHanging = 360
+		}
+		if err := lvl.Validate(); err != nil {
+			return 0, err
+		}
+		list.Levels = append(list.Levels, lvl)
+	}
+
+	d.lists = append(d.lists, list)
+	return writer.CustomListBaseID + len(d.lists) - 1, nil
+}
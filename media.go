@@ -1,7 +1,6 @@
 package mbadocx
 
 import (
-	"github.com/didikprabowo/mbadocx/elements"
 	"github.com/didikprabowo/mbadocx/types"
 )
 
@@ -9,7 +8,12 @@ type Media struct {
 	Media []types.Media
 }
 
-// AddMedia
-func (m *Media) AddMedia(img *elements.Image) {
-	m.Media = append(m.Media, img)
+// AddMedia registers a part to be written; a part whose path is already registered is skipped
+func (m *Media) AddMedia(media types.Media) {
+	for _, existing := range m.Media {
+		if existing.TargetPath() == media.TargetPath() && existing.FileName() == media.FileName() {
+			return
+		}
+	}
+	m.Media = append(m.Media, media)
 }
@@ -0,0 +1,122 @@
+package mbadocx
+
+import (
+	"fmt"
+
+	"github.com/didikprabowo/mbadocx/elements"
+	"github.com/didikprabowo/mbadocx/relationships"
+	"github.com/didikprabowo/mbadocx/types"
+)
+
+// Merge appends the content of another document to the end of this one.
+//
+// The current section of this document is closed with a section break, the
+// body of other is appended including its own section breaks, and the final
+// section of other becomes the final section of this document. Every page
+// keeps the layout it had in its own document, so landscape pages of a
+// merged landscape document stay landscape in a portrait document.
+//
+// The merged content is shared with other rather than copied, so other
+// should not be changed afterwards. The styles of this document format the
+// merged content; style changes made in other, such as
+// EnableHeadingNumbering or SetStyleLineSpacing, are not carried over.
+// Content that refers to parts of its package (embedded or linked images,
+// charts, embedded objects, external hyperlinks, footnotes, lists from
+// DefineList, a table of contents or bookmarks) cannot be merged yet.
+//
+// Parameters:
+//   - other: The document to append; it is not modified
+//
+// Returns:
+//   - error: An error if either document has been closed, other is this document, or other holds content that refers to package parts
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	doc.AddParagraph().AddText("Report")
+//
+//	appendix := mbadocx.New()
+//	appendix.SetLandscape(true)
+//	appendix.AddTable(3, 8)
+//
+//	if err := doc.Merge(appendix); err != nil { // The appendix pages stay landscape
+//	    log.Fatal(err)
+//	}
+func (d *Document) Merge(other *Document) error {
+	if other == nil {
+		return fmt.Errorf("document to merge is nil")
+	}
+	if other == d {
+		return fmt.Errorf("cannot merge a document into itself")
+	}
+
+	// Snapshot other before locking this document, so two documents merging
+	// into each other at the same time cannot deadlock
+	other.mu.RLock()
+	if other.closed {
+		other.mu.RUnlock()
+		return fmt.Errorf("document has been closed")
+	}
+	if err := other.checkMergeable(); err != nil {
+		other.mu.RUnlock()
+		return err
+	}
+	merged := append([]types.Element(nil), other.body.GetElements()...)
+	next := other.section.Clone()
+	other.mu.RUnlock()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	// Close the current section; its page setup moves into the break paragraph
+	p := elements.NewParagraph(d)
+	p.Properties.SectionProperties = d.section.Clone()
+	d.body.AddElement(p)
+
+	for _, el := range merged {
+		d.body.AddElement(el)
+	}
+
+	// The final section of other lays out the appended pages after the break
+	if next.Type == "" {
+		next.Type = "nextPage"
+	}
+	d.section = next
+	return nil
+}
+
+// checkMergeable reports content whose references to package parts would not survive a merge
+func (d *Document) checkMergeable() error {
+	switch {
+	case d.footnotes != nil:
+		return fmt.Errorf("cannot merge a document with footnotes")
+	case len(d.media.Media) > 0:
+		return fmt.Errorf("cannot merge a document with images, charts or embedded parts")
+	case hasPartRelationships(d.relationships):
+		return fmt.Errorf("cannot merge a document with hyperlinks, linked images or other related parts")
+	case len(d.lists) > 0:
+		return fmt.Errorf("cannot merge a document with lists from DefineList")
+	case d.toc != nil || d.bookmarkID > 0:
+		return fmt.Errorf("cannot merge a document with a table of contents or bookmarks")
+	}
+	return nil
+}
+
+// hasPartRelationships reports whether rels holds document relationships beyond the default parts every
+// document has; their IDs would not resolve in another package
+func hasPartRelationships(rels *relationships.Relationships) bool {
+	defaults := make(map[string]bool)
+	for _, rel := range relationships.NewDefault().GetDocumentRelationships() {
+		defaults[rel.Type+" "+rel.Target] = true
+	}
+	for _, rel := range rels.GetDocumentRelationships() {
+		if !defaults[rel.Type+" "+rel.Target] {
+			return true
+		}
+	}
+	return false
+}
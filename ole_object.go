@@ -0,0 +1,74 @@
+package mbadocx
+
+import (
+	"fmt"
+
+	"github.com/didikprabowo/mbadocx/elements"
+	"github.com/didikprabowo/mbadocx/relationships"
+)
+
+// AddOLEObject embeds a file such as a spreadsheet in a new paragraph. Word
+// shows the icon image and opens the object in its application on double-click.
+//
+// Office Open XML files (xlsx, docx, pptx) are stored as packages under
+// word/embeddings/; any other data is stored as an OLE compound file (.bin) and
+// must already be in that format.
+//
+// Parameters:
+//   - data: The file to embed
+//   - progID: Programmatic ID of the application, such as "Excel.Sheet.12",
+//     "Word.Document.12" or "PowerPoint.Show.12"
+//   - icon: Image shown in place of the object, created for this document with
+//     elements.NewImage or elements.NewImageFromBytes; its size is the icon size
+//
+// Returns:
+//   - *elements.OLEObject: The embedded object
+//   - error: An error if the document has been closed, data or progID is empty,
+//     or icon is missing or belongs to another document
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	data, _ := os.ReadFile("budget.xlsx")
+//	icon, err := elements.NewImage(doc, "excel-icon.png")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if _, err := doc.AddOLEObject(data, "Excel.Sheet.12", icon); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) AddOLEObject(data []byte, progID string, icon *elements.Image) (*elements.OLEObject, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return nil, fmt.Errorf("document has been closed")
+	}
+
+	if icon != nil {
+		rel := d.relationships.GetByID(icon.RelationshipID)
+		if rel == nil || rel.Type != relationships.TypeImage || rel.Target != "media/"+icon.FileName() {
+			return nil, fmt.Errorf("icon image must be created for this document")
+		}
+	}
+
+	index := d.relationships.CountByType(relationships.TypePackage) +
+		d.relationships.CountByType(relationships.TypeOLEObject) + 1
+
+	object, err := elements.NewOLEObject(index, data, progID, icon)
+	if err != nil {
+		return nil, err
+	}
+
+	rel := d.relationships.AddEmbedding(object.RelType(), "embeddings/"+object.FileName())
+	object.RelationshipID = rel.ID
+
+	p := elements.NewParagraph(d)
+	p.AddChildren(object)
+	d.body.AddElement(p)
+
+	d.media.AddMedia(icon)
+	d.media.AddMedia(object)
+
+	return object, nil
+}
@@ -1,7 +1,10 @@
 package mbadocx
 
 import (
+	"fmt"
+
 	"github.com/didikprabowo/mbadocx/elements"
+	"github.com/didikprabowo/mbadocx/properties"
 )
 
 // AddParagraph creates and adds a new paragraph element to the document body.
@@ -35,7 +38,7 @@ import (
 //	// Create a paragraph with alignment and spacing
 //	doc.AddParagraph().
 //	    AddText("Centered text").
-//	    SetAlignment(elements.AlignCenter).
+//	    SetAlignment(elements.AlignmentCenter).
 //	    SetSpacingAfter(240) // 240 twips = 12pt
 //
 //	// Add a paragraph with a hyperlink
@@ -46,12 +49,15 @@ import (
 //
 // Common paragraph operations after creation:
 //   - AddText(string): Add plain or formatted text
+//   - Append(text, opts...): Add formatted text and keep chaining on the paragraph,
+//     e.g. Append("a", elements.WithBold()).Append("b")
 //   - AddHyperlink(text, url): Add a clickable link
 //   - AddImage(path): Add an inline image
 //   - AddLineBreak(): Add a line break within the paragraph
 //   - AddPageBreak(): Insert a page break after this paragraph
-//   - SetAlignment(align): Set text alignment (left, center, right, justify)
+//   - SetAlignment(align): Set text alignment (elements.AlignmentLeft, AlignmentCenter, ...)
 //   - SetIndentation(left, right, firstLine): Set paragraph indentation
+//   - SetOutdent(pts): Extend the paragraph into the left margin
 //   - SetSpacing(before, after, line): Set paragraph spacing
 //   - SetStyle(styleName): Apply a predefined paragraph style
 //   - SetNumbering(type, level): Convert to a numbered or bulleted list item
@@ -79,3 +85,187 @@ func (d *Document) AddParagraph() *elements.Paragraph {
 	// This allows users to immediately add content and formatting
 	return paragraphElem
 }
+
+// AddParagraphFunc creates a paragraph, passes it to build for configuration,
+// and then appends it to the document body. This keeps the construction of
+// complex paragraphs in a single closure, the same way Table.SetCellFormattedText
+// takes a func(*Run).
+//
+// Parameters:
+//   - build: Callback that adds content and formatting to the new paragraph. May be nil.
+//
+// Returns:
+//   - *elements.Paragraph: The added paragraph for further formatting
+//
+// Example:
+//
+//	doc.AddParagraphFunc(func(p *elements.Paragraph) {
+//	    p.SetAlignment(elements.AlignmentCenter)
+//	    p.AddText("Status: ")
+//	    if overdue {
+//	        p.AddText("OVERDUE").SetBold(true).SetColor("C00000")
+//	    } else {
+//	        p.AddText("On time").SetColor("00B050")
+//	    }
+//	})
+func (d *Document) AddParagraphFunc(build func(*elements.Paragraph)) *elements.Paragraph {
+	p := elements.NewParagraph(d)
+	if build != nil {
+		build(p)
+	}
+	d.body.AddElement(p)
+	return p
+}
+
+// AddParagraphs adds one plain paragraph per string, in order.
+//
+// Parameters:
+//   - texts: The text of each paragraph; an empty string adds an empty paragraph
+//
+// Returns:
+//   - []*elements.Paragraph: The added paragraphs, in the same order as texts
+//
+// Example:
+//
+//	lines := strings.Split(report, "\n")
+//	for _, p := range doc.AddParagraphs(lines) {
+//	    p.SetSpacing(0, 6)
+//	}
+func (d *Document) AddParagraphs(texts []string) []*elements.Paragraph {
+	paragraphs := make([]*elements.Paragraph, len(texts))
+	for i, text := range texts {
+		p := elements.NewParagraph(d)
+		if text != "" {
+			p.AddText(text)
+		}
+		d.body.AddElement(p)
+		paragraphs[i] = p
+	}
+	return paragraphs
+}
+
+// AddTabbedRow adds a paragraph that lays out values in columns using tab stops
+// instead of a table. Each value is placed in its own run, separated by tabs.
+//
+// Parameters:
+//   - values: The text for each column
+//   - stops: Left-aligned tab stop positions in twips (1440 twips = 1 inch).
+//     Pass len(values)-1 stops to keep the first value at the left margin,
+//     or len(values) stops to place every value on a tab stop.
+//
+// Returns:
+//   - *elements.Paragraph: The created paragraph for further formatting
+//   - error: An error if the document has been closed, the number of stops does not match the values, or the
+//     stops are negative or not increasing (see Paragraph.AddTabbedText)
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//
+//	// Name at the margin, role at 2", salary at 4.5"
+//	doc.AddTabbedRow([]string{"Name", "Role", "Salary"}, []int{2880, 6480})
+//	doc.AddTabbedRow([]string{"Alice", "Engineer", "$120,000"}, []int{2880, 6480})
+//
+// Note: Use SetTabs on the returned paragraph to change the alignment or
+// leader of individual stops (e.g. right-aligned or decimal stops for numbers).
+func (d *Document) AddTabbedRow(values []string, stops []int) (*elements.Paragraph, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return nil, fmt.Errorf("document has been closed")
+	}
+
+	leadingTab := len(stops) == len(values)
+	if !leadingTab && len(stops) != len(values)-1 {
+		return nil, fmt.Errorf("got %d tab stops for %d values, expected %d or %d",
+			len(stops), len(values), len(values)-1, len(values))
+	}
+
+	// The first value stays at the margin unless every value has its own stop
+	segments := make([]elements.TabSegment, len(values))
+	for i, value := range values {
+		segments[i].Text = value
+		if leadingTab {
+			segments[i].Position = stops[i]
+		} else if i > 0 {
+			segments[i].Position = stops[i-1]
+		}
+	}
+
+	p := elements.NewParagraph(d)
+	if err := p.AddTabbedText(segments); err != nil {
+		return nil, err
+	}
+
+	d.body.AddElement(p)
+	return p, nil
+}
+
+// AddPullQuote adds a callout paragraph that stands out from the body text.
+//
+// The quote is indented from both margins, centered and set in larger italic
+// type, with a colored rule above and below and a light gray background. The
+// returned paragraph can be restyled with SetBorders and SetShading.
+//
+// Parameters:
+//   - text: The quoted text
+//
+// Returns:
+//   - *elements.Paragraph: The pull quote paragraph
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	doc.AddPullQuote("Simplicity is prerequisite for reliability.")
+func (d *Document) AddPullQuote(text string) *elements.Paragraph {
+	rule := func() *properties.Border {
+		return &properties.Border{Type: "single", Width: 12, Space: 6, Color: "4472C4"} // 1.5pt accent rule
+	}
+
+	p := elements.NewParagraph(d)
+	p.SetAlignment(elements.AlignmentCenter).
+		SetIndentation(36, 36, 0).
+		SetSpacing(12, 12).
+		SetKeepLines(true).
+		SetBorders(&properties.ParagraphBorders{Top: rule(), Bottom: rule()}).
+		SetShading(&properties.ParagraphShading{Fill: "F2F2F2"})
+	p.AddText(text).SetItalic(true).SetFontSize(14).SetColor("1F3864")
+
+	d.body.AddElement(p)
+	return p
+}
+
+// LabeledFieldTabStop is the tab stop, in twips, at which AddLabeledField
+// aligns values (2 inches from the left margin).
+const LabeledFieldTabStop = 2880
+
+// AddLabeledField adds a form-style paragraph with a bold label, a tab and the
+// value, such as "Name:" followed by "John Doe".
+//
+// The value starts at LabeledFieldTabStop, so consecutive fields line up in
+// one column. A label wider than the column pushes its value to the next
+// default tab stop; use SetTabs on the returned paragraph to move the stop.
+//
+// Parameters:
+//   - label: The label text, including any trailing colon
+//   - value: The value text
+//
+// Returns:
+//   - *elements.Paragraph: The created paragraph for further formatting
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	doc.AddLabeledField("Name:", "John Doe")
+//	doc.AddLabeledField("Date of birth:", "1 May 1990")
+func (d *Document) AddLabeledField(label, value string) *elements.Paragraph {
+	p := elements.NewParagraph(d)
+	p.SetTabs([]properties.TabStop{{Position: LabeledFieldTabStop, Alignment: "left"}})
+
+	p.AddText(label).SetBold(true)
+	p.AddRun().AddTab().AddText(value)
+
+	d.body.AddElement(p)
+	return p
+}
@@ -0,0 +1,33 @@
+package properties
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/didikprabowo/mbadocx/colors"
+)
+
+// ErrInvalidColor is returned when a color is neither a hex value nor a known color name
+var ErrInvalidColor = errors.New("invalid color")
+
+// NormalizeColor converts a color to the 6-digit uppercase hex form used by OOXML.
+// It accepts "RRGGBB", "#RRGGBB", the short "#RGB" form, CSS/Word color names and "auto".
+func NormalizeColor(color string) (string, error) {
+	c := strings.TrimSpace(color)
+	if strings.EqualFold(c, "auto") {
+		return "auto", nil
+	}
+	if hex, ok := colors.Hex(c); ok {
+		return hex, nil
+	}
+
+	c = strings.ToUpper(strings.TrimPrefix(c, "#"))
+	if len(c) == 3 {
+		c = string([]byte{c[0], c[0], c[1], c[1], c[2], c[2]})
+	}
+	if len(c) != 6 || strings.Trim(c, "0123456789ABCDEF") != "" {
+		return "", fmt.Errorf("%w: %q", ErrInvalidColor, color)
+	}
+	return c, nil
+}
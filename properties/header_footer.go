@@ -0,0 +1,39 @@
+package properties
+
+import "fmt"
+
+// HeaderFooterReference links a section to a header or footer part
+type HeaderFooterReference struct {
+	Type           string // default, first, even
+	RelationshipID string
+}
+
+// setReference replaces the reference of the same type, or adds it
+func setReference(refs []HeaderFooterReference, refType, relID string) ([]HeaderFooterReference, error) {
+	switch refType {
+	case "default", "first", "even":
+	default:
+		return refs, fmt.Errorf("invalid header/footer type %q (supported: default, first, even)", refType)
+	}
+	for i := range refs {
+		if refs[i].Type == refType {
+			refs[i].RelationshipID = relID
+			return refs, nil
+		}
+	}
+	return append(refs, HeaderFooterReference{Type: refType, RelationshipID: relID}), nil
+}
+
+// SetHeader links the section to a header part; refType is default, first or even
+func (sp *SectionProperties) SetHeader(refType, relID string) error {
+	refs, err := setReference(sp.Headers, refType, relID)
+	sp.Headers = refs
+	return err
+}
+
+// SetFooter links the section to a footer part; refType is default, first or even
+func (sp *SectionProperties) SetFooter(refType, relID string) error {
+	refs, err := setReference(sp.Footers, refType, relID)
+	sp.Footers = refs
+	return err
+}
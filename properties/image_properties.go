@@ -17,8 +17,16 @@ type ImageProperties struct {
 	// Floating position (when not inline)
 	HorizontalPosition HorizontalAnchor
 	VerticalPosition   VerticalAnchor
-	HorizontalOffset   int64 // Offset in EMUs
-	VerticalOffset     int64 // Offset in EMUs
+	HorizontalOffset   int64  // Offset in EMUs
+	VerticalOffset     int64  // Offset in EMUs
+	HorizontalAlign    string // Named alignment instead of offset: left, center, right, inside, outside
+	VerticalAlign      string // Named alignment instead of offset: top, center, bottom, inside, outside
+
+	// Distance from surrounding text (when floating), in EMUs
+	DistanceTop    int64
+	DistanceBottom int64
+	DistanceLeft   int64
+	DistanceRight  int64
 
 	// Borders and effects
 	BorderWidth int    // Border width in points
@@ -58,8 +66,21 @@ type ImageProperties struct {
 
 	// Z-order (layering)
 	ZOrder int
+
+	// Wrap polygon for tight and through wrapping
+	WrapPolygon []Point
 }
 
+// Point is a wrap polygon vertex in the 0-21600 coordinate space of the image
+// (0,0 is the top-left corner, 21600,21600 the bottom-right corner)
+type Point struct {
+	X int64
+	Y int64
+}
+
+// WrapPolygonMax is the extent of the wrap polygon coordinate space
+const WrapPolygonMax = 21600
+
 // WrapStyle defines how text wraps around the image
 type WrapStyle string
 
@@ -119,6 +140,8 @@ func NewImageProperties() *ImageProperties {
 		Alignment:       AlignLeft,
 		LockAspectRatio: true,
 		AllowOverlap:    true,
+		DistanceLeft:    114300, // 0.125 inch, Word's default
+		DistanceRight:   114300,
 		Brightness:      0,
 		Contrast:        0,
 		Saturation:      100,
@@ -177,33 +200,66 @@ func (p *ImageProperties) GeneratePositionXML() string {
 
 	if !p.Inline {
 		// Floating image positioning
-		xml.WriteString(fmt.Sprintf(`<wp:anchor distT="0" distB="0" distL="114300" distR="114300" simplePos="0" relativeHeight="%d" behindDoc="%s" locked="0" layoutInCell="1" allowOverlap="%s">`,
-			p.ZOrder,
-			boolToString(p.WrapType == WrapBehindText),
+		xml.WriteString(fmt.Sprintf(`<wp:anchor distT="%d" distB="%d" distL="%d" distR="%d" simplePos="0" relativeHeight="%d" behindDoc="%s" locked="0" layoutInCell="1" allowOverlap="%s">`,
+			p.DistanceTop, p.DistanceBottom, p.DistanceLeft, p.DistanceRight,
+			p.RelativeHeight(),
+			boolToString(p.IsBehindText()),
 			boolToString(p.AllowOverlap)))
 
 		// Position settings
 		xml.WriteString(`<wp:simplePos x="0" y="0"/>`)
 		xml.WriteString(fmt.Sprintf(`<wp:positionH relativeFrom="%s">`, p.HorizontalPosition))
-		xml.WriteString(fmt.Sprintf(`<wp:posOffset>%d</wp:posOffset>`, p.HorizontalOffset))
+		if p.HorizontalAlign != "" {
+			xml.WriteString(fmt.Sprintf(`<wp:align>%s</wp:align>`, p.HorizontalAlign))
+		} else {
+			xml.WriteString(fmt.Sprintf(`<wp:posOffset>%d</wp:posOffset>`, p.HorizontalOffset))
+		}
 		xml.WriteString(`</wp:positionH>`)
 		xml.WriteString(fmt.Sprintf(`<wp:positionV relativeFrom="%s">`, p.VerticalPosition))
-		xml.WriteString(fmt.Sprintf(`<wp:posOffset>%d</wp:posOffset>`, p.VerticalOffset))
+		if p.VerticalAlign != "" {
+			xml.WriteString(fmt.Sprintf(`<wp:align>%s</wp:align>`, p.VerticalAlign))
+		} else {
+			xml.WriteString(fmt.Sprintf(`<wp:posOffset>%d</wp:posOffset>`, p.VerticalOffset))
+		}
 		xml.WriteString(`</wp:positionV>`)
 	}
 
 	return xml.String()
 }
 
+// Base relativeHeight values used by Word for objects behind and in front of text
+const (
+	relativeHeightBehind = 251658240
+	relativeHeightFront  = 251659264
+)
+
+// MaxZOrder is the highest ZOrder; the 1024 values between the two bases keep
+// images behind text below images in front of it
+const MaxZOrder = relativeHeightFront - relativeHeightBehind - 1
+
+// IsBehindText reports whether a floating image is placed behind the text layer
+func (p *ImageProperties) IsBehindText() bool {
+	return p.WrapType == WrapBehindText
+}
+
+// RelativeHeight returns the z-order written as relativeHeight; higher values are drawn on top.
+// Images behind text always stay below images in front of text.
+func (p *ImageProperties) RelativeHeight() int {
+	if p.IsBehindText() {
+		return relativeHeightBehind + p.ZOrder
+	}
+	return relativeHeightFront + p.ZOrder
+}
+
 // GenerateWrapXML generates the XML for text wrapping
 func (p *ImageProperties) GenerateWrapXML() string {
 	switch p.WrapType {
 	case WrapSquare:
 		return `<wp:wrapSquare wrapText="bothSides"/>`
 	case WrapTight:
-		return `<wp:wrapTight wrapText="bothSides"/>`
+		return `<wp:wrapTight wrapText="bothSides">` + p.GenerateWrapPolygonXML() + `</wp:wrapTight>`
 	case WrapThrough:
-		return `<wp:wrapThrough wrapText="bothSides"/>`
+		return `<wp:wrapThrough wrapText="bothSides">` + p.GenerateWrapPolygonXML() + `</wp:wrapThrough>`
 	case WrapTopAndBottom:
 		return `<wp:wrapTopAndBottom/>`
 	case WrapBehindText:
@@ -215,6 +271,31 @@ func (p *ImageProperties) GenerateWrapXML() string {
 	}
 }
 
+// GenerateWrapPolygonXML generates the wrap polygon, defaulting to the image rectangle
+func (p *ImageProperties) GenerateWrapPolygonXML() string {
+	points := p.WrapPolygon
+	if len(points) < 3 {
+		points = []Point{{0, 0}, {0, WrapPolygonMax}, {WrapPolygonMax, WrapPolygonMax}, {WrapPolygonMax, 0}}
+	}
+
+	var xml strings.Builder
+	edited := len(p.WrapPolygon) >= 3
+	xml.WriteString(fmt.Sprintf(`<wp:wrapPolygon edited="%s">`, boolToString(edited)))
+	xml.WriteString(fmt.Sprintf(`<wp:start x="%d" y="%d"/>`, points[0].X, points[0].Y))
+	for _, pt := range points[1:] {
+		xml.WriteString(fmt.Sprintf(`<wp:lineTo x="%d" y="%d"/>`, pt.X, pt.Y))
+	}
+
+	// Close the polygon
+	last := points[len(points)-1]
+	if last != points[0] {
+		xml.WriteString(fmt.Sprintf(`<wp:lineTo x="%d" y="%d"/>`, points[0].X, points[0].Y))
+	}
+	xml.WriteString(`</wp:wrapPolygon>`)
+
+	return xml.String()
+}
+
 // GenerateEffectsXML generates the XML for image effects
 func (p *ImageProperties) GenerateEffectsXML() string {
 	var xml strings.Builder
@@ -357,6 +438,26 @@ func (p *ImageProperties) Validate() error {
 		return fmt.Errorf("total crop cannot exceed 100%%")
 	}
 
+	if p.DistanceTop < 0 || p.DistanceBottom < 0 || p.DistanceLeft < 0 || p.DistanceRight < 0 {
+		return fmt.Errorf("distance from text cannot be negative")
+	}
+
+	if p.ZOrder < 0 || p.ZOrder > MaxZOrder {
+		return fmt.Errorf("z-order must be between 0 and %d", MaxZOrder)
+	}
+
+	switch p.HorizontalAlign {
+	case "", "left", "center", "right", "inside", "outside":
+	default:
+		return fmt.Errorf("invalid horizontal alignment: %s", p.HorizontalAlign)
+	}
+
+	switch p.VerticalAlign {
+	case "", "top", "center", "bottom", "inside", "outside":
+	default:
+		return fmt.Errorf("invalid vertical alignment: %s", p.VerticalAlign)
+	}
+
 	return nil
 }
 
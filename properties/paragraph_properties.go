@@ -2,7 +2,9 @@
 package properties
 
 import (
+	"bytes"
 	"fmt"
+	"math"
 )
 
 // ParagraphProperties defines paragraph formatting
@@ -17,7 +19,7 @@ type ParagraphProperties struct {
 	IndentFirstLine float64 // First line indent (negative for hanging)
 	IndentHanging   float64 // Hanging indent (alternative to negative FirstLine)
 
-	// Spacing (in points)
+	// Spacing (in points); negative values inherit the style's spacing
 	SpacingBefore     float64 // Space before paragraph
 	SpacingAfter      float64 // Space after paragraph
 	SpacingBeforeAuto bool    // Auto space before
@@ -32,12 +34,13 @@ type ParagraphProperties struct {
 	KeepLines       bool // Keep lines together
 	PageBreakBefore bool // Page break before paragraph
 	WidowControl    bool // Widow/orphan control
+	WidowControlSet bool // WidowControl was set explicitly and overrides the style
 
 	// Paragraph style
 	StyleID string // Reference to paragraph style
 
 	// Outline and numbering
-	OutlineLevel   int    // Outline level (0 = body text, 1-9 = heading levels)
+	OutlineLevel   *int   // Outline level, 0-based like <w:outlineLvl> (0 = Heading 1 ... 8 = Heading 9); nil = body text
 	NumberingID    string // Numbering definition ID
 	NumberingLevel int    // Numbering level (0-8)
 
@@ -67,6 +70,9 @@ type ParagraphProperties struct {
 	// Frame properties
 	Frame *ParagraphFrame
 
+	// Paragraph mark formatting (<w:rPr> inside <w:pPr>)
+	MarkProperties *RunProperties
+
 	// Section properties (for last paragraph in section)
 	SectionProperties *SectionProperties
 }
@@ -132,7 +138,8 @@ func NewParagraphProperties() *ParagraphProperties {
 		LineSpacingRule: "auto",
 		WidowControl:    true, // Default widow control on
 		SnapToGrid:      true, // Default snap to grid
-		SpacingAfter:    8,    // Default 8pt after
+		SpacingBefore:   -1,   // Inherit from the paragraph style
+		SpacingAfter:    -1,   // Inherit from the paragraph style
 	}
 }
 
@@ -159,8 +166,8 @@ func (pp *ParagraphProperties) Clone() *ParagraphProperties {
 		KeepLines:           pp.KeepLines,
 		PageBreakBefore:     pp.PageBreakBefore,
 		WidowControl:        pp.WidowControl,
+		WidowControlSet:     pp.WidowControlSet,
 		StyleID:             pp.StyleID,
-		OutlineLevel:        pp.OutlineLevel,
 		NumberingID:         pp.NumberingID,
 		NumberingLevel:      pp.NumberingLevel,
 		BiDi:                pp.BiDi,
@@ -175,6 +182,11 @@ func (pp *ParagraphProperties) Clone() *ParagraphProperties {
 		DivID:               pp.DivID,
 	}
 
+	if pp.OutlineLevel != nil {
+		level := *pp.OutlineLevel
+		clone.OutlineLevel = &level
+	}
+
 	// Clone complex properties
 	if pp.Borders != nil {
 		clone.Borders = pp.Borders.Clone()
@@ -198,6 +210,10 @@ func (pp *ParagraphProperties) Clone() *ParagraphProperties {
 		clone.Frame = pp.Frame.Clone()
 	}
 
+	if pp.MarkProperties != nil {
+		clone.MarkProperties = pp.MarkProperties.Clone()
+	}
+
 	if pp.SectionProperties != nil {
 		clone.SectionProperties = pp.SectionProperties.Clone()
 	}
@@ -221,6 +237,9 @@ func (pp *ParagraphProperties) Merge(other *ParagraphProperties) {
 	if other.StyleID != "" {
 		pp.StyleID = other.StyleID
 	}
+	if other.OutlineLevel != nil {
+		pp.OutlineLevel = other.OutlineLevel
+	}
 
 	// Merge numeric properties (only if non-zero)
 	if other.IndentLeft != 0 {
@@ -231,22 +250,36 @@ func (pp *ParagraphProperties) Merge(other *ParagraphProperties) {
 	}
 	if other.IndentFirstLine != 0 {
 		pp.IndentFirstLine = other.IndentFirstLine
+		pp.IndentHanging = 0
+	}
+	if other.IndentHanging != 0 {
+		pp.IndentHanging = other.IndentHanging
+		pp.IndentFirstLine = 0
 	}
-	if other.SpacingBefore != 0 {
+	// Negative spacing inherits from the style; an explicit 0 is kept
+	if other.SpacingBefore >= 0 {
 		pp.SpacingBefore = other.SpacingBefore
 	}
-	if other.SpacingAfter != 0 {
+	if other.SpacingAfter >= 0 {
 		pp.SpacingAfter = other.SpacingAfter
 	}
 	if other.LineSpacing != 0 {
 		pp.LineSpacing = other.LineSpacing
 	}
 
+	if other.SpacingBeforeAuto {
+		pp.SpacingBeforeAuto = true
+	}
+	if other.SpacingAfterAuto {
+		pp.SpacingAfterAuto = true
+	}
+
 	// Merge boolean properties (always take from other)
 	pp.KeepNext = other.KeepNext
 	pp.KeepLines = other.KeepLines
 	pp.PageBreakBefore = other.PageBreakBefore
 	pp.WidowControl = other.WidowControl
+	pp.WidowControlSet = pp.WidowControlSet || other.WidowControlSet
 	pp.BiDi = other.BiDi
 
 	// Merge complex properties
@@ -260,6 +293,12 @@ func (pp *ParagraphProperties) Merge(other *ParagraphProperties) {
 		pp.Tabs = make([]TabStop, len(other.Tabs))
 		copy(pp.Tabs, other.Tabs)
 	}
+	if other.MarkProperties != nil {
+		if pp.MarkProperties == nil {
+			pp.MarkProperties = &RunProperties{}
+		}
+		pp.MarkProperties.Merge(other.MarkProperties.Clone())
+	}
 }
 
 // Reset clears all formatting
@@ -280,20 +319,26 @@ func (pp *ParagraphProperties) IsEmpty() bool {
 		pp.IndentLeft == 0 &&
 		pp.IndentRight == 0 &&
 		pp.IndentFirstLine == 0 &&
-		pp.SpacingBefore == 0 &&
+		pp.IndentHanging == 0 &&
+		pp.SpacingBefore == def.SpacingBefore &&
 		pp.SpacingAfter == def.SpacingAfter &&
 		pp.LineSpacing == def.LineSpacing &&
 		pp.LineSpacingRule == def.LineSpacingRule &&
+		!pp.SpacingBeforeAuto &&
+		!pp.SpacingAfterAuto &&
 		!pp.KeepNext &&
 		!pp.KeepLines &&
 		!pp.PageBreakBefore &&
 		pp.WidowControl == def.WidowControl &&
+		!pp.WidowControlSet &&
 		pp.StyleID == "" &&
-		pp.OutlineLevel == 0 &&
+		pp.OutlineLevel == nil &&
 		pp.NumberingID == "" &&
 		pp.Borders == nil &&
 		pp.Shading == nil &&
-		len(pp.Tabs) == 0
+		len(pp.Tabs) == 0 &&
+		pp.MarkProperties == nil &&
+		pp.SectionProperties == nil
 }
 
 // Validate validates the paragraph properties
@@ -304,6 +349,7 @@ func (pp *ParagraphProperties) Validate() error {
 		"center":     true,
 		"right":      true,
 		"justify":    true,
+		"both":       true,
 		"distribute": true,
 		"start":      true,
 		"end":        true,
@@ -335,8 +381,8 @@ func (pp *ParagraphProperties) Validate() error {
 	}
 
 	// Validate outline level
-	if pp.OutlineLevel < 0 || pp.OutlineLevel > 9 {
-		return fmt.Errorf("outline level must be between 0 and 9: %d", pp.OutlineLevel)
+	if pp.OutlineLevel != nil && (*pp.OutlineLevel < 0 || *pp.OutlineLevel > 8) {
+		return fmt.Errorf("outline level must be between 0 and 8: %d", *pp.OutlineLevel)
 	}
 
 	// Validate numbering level
@@ -408,8 +454,49 @@ func (pb *ParagraphBorders) Validate() error {
 
 // XML generates XML for paragraph borders
 func (pb *ParagraphBorders) XML() ([]byte, error) {
-	// Implementation would generate proper OOXML
-	return nil, nil
+	if err := pb.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Element order follows CT_PBdr
+	sides := []struct {
+		name   string
+		border *Border
+	}{
+		{"top", pb.Top}, {"left", pb.Left}, {"bottom", pb.Bottom},
+		{"right", pb.Right}, {"between", pb.Between}, {"bar", pb.Bar},
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`<w:pBdr>`)
+	for _, side := range sides {
+		if side.border != nil {
+			buf.WriteString(side.border.xml(side.name))
+		}
+	}
+	buf.WriteString(`</w:pBdr>`)
+	return buf.Bytes(), nil
+}
+
+// xml generates a single border element; an empty type draws a single line and an empty color is automatic
+func (b *Border) xml(name string) string {
+	typ := b.Type
+	if typ == "" {
+		typ = "single"
+	}
+	color := b.Color
+	if color == "" {
+		color = "auto"
+	}
+
+	s := fmt.Sprintf(`<w:%s w:val="%s" w:sz="%d" w:space="%d" w:color="%s"`, name, typ, b.Width, b.Space, color)
+	if b.Shadow {
+		s += ` w:shadow="1"`
+	}
+	if b.Frame {
+		s += ` w:frame="1"`
+	}
+	return s + `/>`
 }
 
 // Clone creates a copy of Border
@@ -453,10 +540,25 @@ func (b *Border) Validate() error {
 	return nil
 }
 
-// XML generates XML for paragraph shading
+// XML generates XML for paragraph shading; the pattern defaults to clear so only the fill shows
 func (ps *ParagraphShading) XML() ([]byte, error) {
-	// Implementation would generate proper OOXML
-	return nil, nil
+	pattern := ps.Pattern
+	if pattern == "" {
+		pattern = "clear"
+	}
+	color := ps.Color
+	if color == "" {
+		color = ps.PatternColor
+	}
+	if color == "" {
+		color = "auto"
+	}
+	fill := ps.Fill
+	if fill == "" {
+		fill = "auto"
+	}
+
+	return []byte(fmt.Sprintf(`<w:shd w:val="%s" w:color="%s" w:fill="%s"/>`, pattern, color, fill)), nil
 }
 
 // Validate validates a tab stop
@@ -571,3 +673,26 @@ const (
 	ShadingPatternPct25      = "pct25"
 	ShadingPatternPct50      = "pct50"
 )
+
+// EffectiveHanging returns the hanging indent in points, from IndentHanging or a negative IndentFirstLine
+func (pp *ParagraphProperties) EffectiveHanging() float64 {
+	if pp.IndentHanging > 0 {
+		return pp.IndentHanging
+	}
+	if pp.IndentFirstLine < 0 {
+		return -pp.IndentFirstLine
+	}
+	return 0
+}
+
+// LineSpacingValue converts a line spacing and rule to the w:line and w:lineRule attribute values.
+// For "exact" and "atLeast" spacing is in points; otherwise it is a multiple of single spacing
+// (240 = single, 276 = 1.15, 360 = 1.5, 480 = double).
+func LineSpacingValue(spacing float64, rule string) (int, string) {
+	switch rule {
+	case "exact", "atLeast":
+		return int(math.Round(spacing * 20)), rule
+	default:
+		return int(math.Round(spacing * 240)), "auto"
+	}
+}
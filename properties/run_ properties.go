@@ -31,11 +31,18 @@ type RunProperties struct {
 	Emboss        *bool  // Emboss effect
 	Imprint       *bool  // Imprint/engrave effect
 	Vanish        *bool  // Hidden/vanish text
+	EmphasisMark  string // East Asian emphasis mark: none, dot, comma, circle, underDot
 
 	// Spacing and positioning
 	Spacing  int     // Character spacing in twips (1/20th of a point)
 	Kerning  float64 // Kerning in points (minimum font size for kerning)
 	Position int     // Text position (raise/lower) in half-points
+	Scale    int     // Horizontal character scaling in percent (1-600, 0 = default 100%)
+
+	// OpenType typography (Word 2010+)
+	Ligatures     string // Ligatures: none, standard, contextual, historical, discretional, standardContextual, ..., all
+	NumberForm    string // Digit form: default, lining, oldStyle
+	NumberSpacing string // Digit spacing: default, proportional, tabular
 
 	// Style reference
 	StyleID string // Character style ID
@@ -91,7 +98,7 @@ func NewRunProperties() *RunProperties {
 		Color:         "",        // Default color (black)
 		Highlight:     "",        // No highlight
 		VerticalAlign: "",        // Baseline by default
-		Language:      "en-US",   // Default language
+		Language:      "",        // Inherit the document language
 	}
 }
 
@@ -111,9 +118,14 @@ func (rp *RunProperties) Clone() *RunProperties {
 		Spacing:       rp.Spacing,
 		Kerning:       rp.Kerning,
 		Position:      rp.Position,
+		Scale:         rp.Scale,
 		StyleID:       rp.StyleID,
 		Language:      rp.Language,
 		Animation:     rp.Animation,
+		EmphasisMark:  rp.EmphasisMark,
+		Ligatures:     rp.Ligatures,
+		NumberForm:    rp.NumberForm,
+		NumberSpacing: rp.NumberSpacing,
 	}
 
 	// Clone pointer fields
@@ -282,6 +294,9 @@ func (rp *RunProperties) Merge(other *RunProperties) {
 	if other.Vanish != nil {
 		rp.Vanish = other.Vanish
 	}
+	if other.EmphasisMark != "" {
+		rp.EmphasisMark = other.EmphasisMark
+	}
 
 	// Merge spacing
 	if other.Spacing != 0 {
@@ -293,6 +308,20 @@ func (rp *RunProperties) Merge(other *RunProperties) {
 	if other.Position != 0 {
 		rp.Position = other.Position
 	}
+	if other.Scale != 0 {
+		rp.Scale = other.Scale
+	}
+
+	// Merge typography
+	if other.Ligatures != "" {
+		rp.Ligatures = other.Ligatures
+	}
+	if other.NumberForm != "" {
+		rp.NumberForm = other.NumberForm
+	}
+	if other.NumberSpacing != "" {
+		rp.NumberSpacing = other.NumberSpacing
+	}
 
 	// Merge other properties
 	if other.StyleID != "" {
@@ -316,6 +345,13 @@ func (rp *RunProperties) Reset() {
 
 // Validate validates the run properties
 func (rp *RunProperties) Validate() error {
+	// Validate text color
+	if rp.Color != "" {
+		if _, err := NormalizeColor(rp.Color); err != nil {
+			return err
+		}
+	}
+
 	// Validate underline values
 	validUnderlines := map[string]bool{
 		"":           true,
@@ -372,6 +408,16 @@ func (rp *RunProperties) Validate() error {
 		return fmt.Errorf("invalid vertical alignment: %s", rp.VerticalAlign)
 	}
 
+	// Validate emphasis mark
+	if !ValidEmphasisMark(rp.EmphasisMark) {
+		return fmt.Errorf("invalid emphasis mark: %s", rp.EmphasisMark)
+	}
+
+	// Validate typography
+	if err := rp.ValidateTypography(); err != nil {
+		return err
+	}
+
 	// Validate font size
 	if rp.FontSize < 0 {
 		return fmt.Errorf("font size cannot be negative: %f", rp.FontSize)
@@ -382,6 +428,11 @@ func (rp *RunProperties) Validate() error {
 		return fmt.Errorf("kerning cannot be negative: %f", rp.Kerning)
 	}
 
+	// Validate character scale
+	if rp.Scale < 0 || rp.Scale > 600 {
+		return fmt.Errorf("character scale must be between 1 and 600 percent: %d", rp.Scale)
+	}
+
 	// Validate border
 	if rp.Border != nil {
 		validBorderTypes := map[string]bool{
@@ -441,6 +492,42 @@ func (rp *RunProperties) Validate() error {
 	return nil
 }
 
+// ValidEmphasisMark reports whether mark is an ST_Em value; empty means inherit
+func ValidEmphasisMark(mark string) bool {
+	switch mark {
+	case "", "none", "dot", "comma", "circle", "underDot":
+		return true
+	}
+	return false
+}
+
+// ligatureModes lists the w14:ligatures values: none, all, or any ordered combination of the four ligature kinds
+var ligatureModes = map[string]bool{
+	"none": true, "standard": true, "contextual": true, "historical": true, "discretional": true,
+	"standardContextual": true, "standardHistorical": true, "contextualHistorical": true,
+	"standardDiscretional": true, "contextualDiscretional": true, "historicalDiscretional": true,
+	"standardContextualHistorical": true, "standardContextualDiscretional": true,
+	"standardHistoricalDiscretional": true, "contextualHistoricalDiscretional": true, "all": true,
+}
+
+// ValidateTypography checks the OpenType ligature and number settings
+func (rp *RunProperties) ValidateTypography() error {
+	if rp.Ligatures != "" && !ligatureModes[rp.Ligatures] {
+		return fmt.Errorf("invalid ligatures: %s", rp.Ligatures)
+	}
+	switch rp.NumberForm {
+	case "", "default", "lining", "oldStyle":
+	default:
+		return fmt.Errorf("invalid number form: %s", rp.NumberForm)
+	}
+	switch rp.NumberSpacing {
+	case "", "default", "proportional", "tabular":
+	default:
+		return fmt.Errorf("invalid number spacing: %s", rp.NumberSpacing)
+	}
+	return nil
+}
+
 // IsEmpty returns true if no formatting is applied
 func (rp *RunProperties) IsEmpty() bool {
 	if rp == nil {
@@ -464,6 +551,10 @@ func (rp *RunProperties) IsEmpty() bool {
 		rp.Emboss == nil &&
 		rp.Imprint == nil &&
 		rp.Vanish == nil &&
+		rp.EmphasisMark == "" &&
+		rp.Ligatures == "" &&
+		rp.NumberForm == "" &&
+		rp.NumberSpacing == "" &&
 		rp.Spacing == 0 &&
 		rp.Kerning == 0 &&
 		rp.Position == 0 &&
@@ -0,0 +1,79 @@
+package properties
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// wordNamespace is the WordprocessingML main namespace used by document.xml
+const wordNamespace = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+
+// ParsePageSetup reads the page size and margins of the last section from
+// word/document.xml of an existing document or template. The final
+// <w:sectPr> of the body comes after any section breaks, so the last
+// <w:pgSz> and <w:pgMar> in the part win. Either result is nil when the
+// part does not set it.
+func ParsePageSetup(data []byte) (*PageSize, *PageMargins, error) {
+	var size *PageSize
+	var margins *PageMargins
+
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing document.xml: %w", err)
+		}
+
+		el, ok := tok.(xml.StartElement)
+		if !ok || el.Name.Space != wordNamespace {
+			continue
+		}
+
+		switch el.Name.Local {
+		case "pgSz":
+			size = &PageSize{
+				Width:       atoi(attr(el, "w")),
+				Height:      atoi(attr(el, "h")),
+				Orientation: attr(el, "orient"),
+				Code:        atoi(attr(el, "code")),
+			}
+			if size.Orientation == "" {
+				size.Orientation = "portrait"
+			}
+		case "pgMar":
+			margins = &PageMargins{
+				Top:    atoi(attr(el, "top")),
+				Right:  atoi(attr(el, "right")),
+				Bottom: atoi(attr(el, "bottom")),
+				Left:   atoi(attr(el, "left")),
+				Header: atoi(attr(el, "header")),
+				Footer: atoi(attr(el, "footer")),
+				Gutter: atoi(attr(el, "gutter")),
+			}
+		}
+	}
+
+	return size, margins, nil
+}
+
+// attr returns the value of a w: attribute, or "" if absent
+func attr(el xml.StartElement, local string) string {
+	for _, a := range el.Attr {
+		if a.Name.Local == local && (a.Name.Space == wordNamespace || a.Name.Space == "") {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// atoi parses an integer attribute, returning 0 when it is missing or invalid
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
@@ -1,20 +1,40 @@
 package properties
 
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
 // SectionProperties defines section formatting
 type SectionProperties struct {
 	Type           string // continuous, nextPage, nextColumn, evenPage, oddPage
+	Headers        []HeaderFooterReference
+	Footers        []HeaderFooterReference
 	PageSize       *PageSize
 	PageMargins    *PageMargins
 	Columns        *Columns
 	PageNumbering  *PageNumbering
-	HeaderDistance int
-	FooterDistance int
+	HeaderDistance int // Distance from the page top to the header in twips; overrides PageMargins.Header when positive
+	FooterDistance int // Distance from the page bottom to the footer in twips; overrides PageMargins.Footer when positive
 	Gutter         int
 	LineNumbers    *LineNumbers
 	DocGrid        *DocumentGrid
 	FormProtection bool
-	VerticalAlign  string // top, center, bottom, justify
+	VerticalAlign  string // top, center, bottom, both (justify)
 	BiDi           bool   // Right-to-left section
+	TitlePage      bool   // First page uses its own header and footer
+	Footnotes      *FootnoteSettings
+	Endnotes       *FootnoteSettings // Endnote numbering; Position is sectEnd or docEnd
+	NoEndnote      bool              // Endnotes are not placed at the end of this section
+}
+
+// FootnoteSettings defines how footnotes or endnotes are numbered and placed
+type FootnoteSettings struct {
+	Position     string // pageBottom, beneathText (endnotes: sectEnd, docEnd); empty uses Word's default
+	NumberFormat string // decimal, lowerRoman, upperRoman, lowerLetter, upperLetter, chicago
+	StartAt      int    // First footnote number; 0 uses Word's default (1)
+	RestartRule  string // continuous, eachSect, eachPage
 }
 
 // PageSize defines page dimensions
@@ -53,7 +73,7 @@ type Column struct {
 
 // PageNumbering defines page numbering
 type PageNumbering struct {
-	Start        int
+	Start        int    // Restart the section at this page number; 0 continues from the previous section
 	Format       string // decimal, upperRoman, lowerRoman, upperLetter, lowerLetter
 	ChapterSep   string // hyphen, period, colon, emDash, enDash
 	ChapterStyle string
@@ -74,6 +94,113 @@ type DocumentGrid struct {
 	CharSpace int
 }
 
+// footnoteFormats lists the number formats accepted for footnotes
+var footnoteFormats = map[string]bool{
+	"decimal": true, "lowerRoman": true, "upperRoman": true,
+	"lowerLetter": true, "upperLetter": true, "chicago": true,
+}
+
+// Validate checks the footnote settings against the values Word accepts
+func (fs *FootnoteSettings) Validate() error {
+	switch fs.Position {
+	case "", "pageBottom", "beneathText":
+	default:
+		return fmt.Errorf("invalid footnote position: %s", fs.Position)
+	}
+	return fs.validateNumbering("footnote")
+}
+
+// ValidateEndnotes checks the settings as endnote settings; endnotes sit at the end of a section or the document
+// and cannot restart on each page
+func (fs *FootnoteSettings) ValidateEndnotes() error {
+	switch fs.Position {
+	case "", "sectEnd", "docEnd":
+	default:
+		return fmt.Errorf("invalid endnote position: %s", fs.Position)
+	}
+	if fs.RestartRule == "eachPage" {
+		return fmt.Errorf("invalid endnote restart rule: %s", fs.RestartRule)
+	}
+	return fs.validateNumbering("endnote")
+}
+
+// validateNumbering checks the number format, start and restart rule shared by footnotes and endnotes
+func (fs *FootnoteSettings) validateNumbering(kind string) error {
+	if fs.NumberFormat != "" && !footnoteFormats[fs.NumberFormat] {
+		return fmt.Errorf("invalid %s number format: %s", kind, fs.NumberFormat)
+	}
+	if fs.StartAt < 0 {
+		return fmt.Errorf("%s numbering cannot start at %d", kind, fs.StartAt)
+	}
+	switch fs.RestartRule {
+	case "", "continuous", "eachSect", "eachPage":
+	default:
+		return fmt.Errorf("invalid %s restart rule: %s", kind, fs.RestartRule)
+	}
+	return nil
+}
+
+// xml writes the settings as <w:footnotePr> or <w:endnotePr>
+func (fs *FootnoteSettings) xml(buf *bytes.Buffer, name string) {
+	fmt.Fprintf(buf, `<w:%s>`, name)
+	if fs.Position != "" {
+		fmt.Fprintf(buf, `<w:pos w:val="%s"/>`, fs.Position)
+	}
+	if fs.NumberFormat != "" {
+		fmt.Fprintf(buf, `<w:numFmt w:val="%s"/>`, fs.NumberFormat)
+	}
+	if fs.StartAt > 0 {
+		fmt.Fprintf(buf, `<w:numStart w:val="%d"/>`, fs.StartAt)
+	}
+	if fs.RestartRule != "" {
+		fmt.Fprintf(buf, `<w:numRestart w:val="%s"/>`, fs.RestartRule)
+	}
+	fmt.Fprintf(buf, `</w:%s>`, name)
+}
+
+// Paper size codes written to <w:pgSz w:code> (Windows DMPAPER values)
+const (
+	PaperCodeLetter = 1
+	PaperCodeLegal  = 5
+	PaperCodeA3     = 8
+	PaperCodeA4     = 9
+	PaperCodeA5     = 11
+)
+
+// paperSizes maps paper names to portrait dimensions in twips
+var paperSizes = map[string]PageSize{
+	"a3":     {Width: 16838, Height: 23811, Code: PaperCodeA3},
+	"a4":     {Width: 11906, Height: 16838, Code: PaperCodeA4},
+	"a5":     {Width: 8391, Height: 11906, Code: PaperCodeA5},
+	"letter": {Width: 12240, Height: 15840, Code: PaperCodeLetter},
+	"legal":  {Width: 12240, Height: 20160, Code: PaperCodeLegal},
+}
+
+// PaperSize returns the portrait page size of a named paper ("A4", "Letter", "Legal", "A3", "A5")
+func PaperSize(name string) (PageSize, bool) {
+	size, ok := paperSizes[strings.ToLower(strings.TrimSpace(name))]
+	if ok {
+		size.Orientation = "portrait"
+	}
+	return size, ok
+}
+
+// NewSectionProperties creates section properties for a US Letter portrait page with 1 inch margins
+func NewSectionProperties() *SectionProperties {
+	size, _ := PaperSize("Letter")
+	return &SectionProperties{
+		PageSize: &size,
+		PageMargins: &PageMargins{
+			Top:    1440,
+			Right:  1440,
+			Bottom: 1440,
+			Left:   1440,
+			Header: 720,
+			Footer: 720,
+		},
+	}
+}
+
 // Clone creates a copy of SectionProperties
 func (sp *SectionProperties) Clone() *SectionProperties {
 	if sp == nil {
@@ -88,8 +215,13 @@ func (sp *SectionProperties) Clone() *SectionProperties {
 		FormProtection: sp.FormProtection,
 		VerticalAlign:  sp.VerticalAlign,
 		BiDi:           sp.BiDi,
+		TitlePage:      sp.TitlePage,
+		NoEndnote:      sp.NoEndnote,
 	}
 
+	clone.Headers = append([]HeaderFooterReference(nil), sp.Headers...)
+	clone.Footers = append([]HeaderFooterReference(nil), sp.Footers...)
+
 	if sp.PageSize != nil {
 		clone.PageSize = &PageSize{
 			Width:       sp.PageSize.Width,
@@ -99,6 +231,26 @@ func (sp *SectionProperties) Clone() *SectionProperties {
 		}
 	}
 
+	if sp.Footnotes != nil {
+		footnotes := *sp.Footnotes
+		clone.Footnotes = &footnotes
+	}
+
+	if sp.Endnotes != nil {
+		endnotes := *sp.Endnotes
+		clone.Endnotes = &endnotes
+	}
+
+	if sp.PageNumbering != nil {
+		numbering := *sp.PageNumbering
+		clone.PageNumbering = &numbering
+	}
+
+	if sp.DocGrid != nil {
+		grid := *sp.DocGrid
+		clone.DocGrid = &grid
+	}
+
 	if sp.PageMargins != nil {
 		clone.PageMargins = &PageMargins{
 			Top:    sp.PageMargins.Top,
@@ -113,3 +265,221 @@ func (sp *SectionProperties) Clone() *SectionProperties {
 
 	return clone
 }
+
+// SetLandscape sets the page orientation, swapping the page dimensions and rotating the margins when it changes
+func (sp *SectionProperties) SetLandscape(landscape bool) *SectionProperties {
+	ps := sp.PageSize
+	if ps == nil {
+		return sp
+	}
+
+	// Width and height may be stored in either order; normalize to the requested orientation
+	wide := ps.Width > ps.Height
+	if wide != landscape {
+		ps.Width, ps.Height = ps.Height, ps.Width
+		// Rotate the margins with the page, and back again when returning to portrait
+		if pm := sp.PageMargins; pm != nil {
+			if landscape {
+				pm.Top, pm.Right, pm.Bottom, pm.Left = pm.Left, pm.Top, pm.Right, pm.Bottom
+			} else {
+				pm.Top, pm.Right, pm.Bottom, pm.Left = pm.Right, pm.Bottom, pm.Left, pm.Top
+			}
+		}
+	}
+
+	if landscape {
+		ps.Orientation = "landscape"
+	} else {
+		ps.Orientation = "portrait"
+	}
+	return sp
+}
+
+// SetHeaderFooterDistance sets the distance of the header from the page top and the footer from the page bottom, in twips
+func (sp *SectionProperties) SetHeaderFooterDistance(header, footer int) error {
+	if header < 0 || footer < 0 {
+		return fmt.Errorf("header and footer distances must not be negative: %d, %d", header, footer)
+	}
+	sp.HeaderDistance = header
+	sp.FooterDistance = footer
+	if sp.PageMargins == nil {
+		sp.PageMargins = &PageMargins{}
+	}
+	sp.PageMargins.Header = header
+	sp.PageMargins.Footer = footer
+	return nil
+}
+
+// verticalJc maps a vertical alignment to its ST_VerticalJc value; "justify" is accepted for "both"
+func verticalJc(align string) (string, bool) {
+	switch align {
+	case "top", "center", "bottom", "both":
+		return align, true
+	case "justify":
+		return "both", true
+	}
+	return "", false
+}
+
+// SetVerticalAlign sets how text is aligned vertically between the top and bottom margins
+func (sp *SectionProperties) SetVerticalAlign(align string) error {
+	if _, ok := verticalJc(align); !ok {
+		return fmt.Errorf("invalid section vertical alignment %q (supported: top, center, bottom, both)", align)
+	}
+	sp.VerticalAlign = align
+	return nil
+}
+
+// Validate checks the page numbering against the values Word accepts
+func (pn *PageNumbering) Validate() error {
+	switch pn.Format {
+	case "", "decimal", "upperRoman", "lowerRoman", "upperLetter", "lowerLetter":
+	default:
+		return fmt.Errorf("invalid page number format: %s", pn.Format)
+	}
+	if pn.Start < 0 {
+		return fmt.Errorf("page numbering cannot start at %d", pn.Start)
+	}
+	switch pn.ChapterSep {
+	case "", "hyphen", "period", "colon", "emDash", "enDash":
+	default:
+		return fmt.Errorf("invalid chapter separator: %s", pn.ChapterSep)
+	}
+	return nil
+}
+
+// Validate checks the document grid against the values Word accepts
+func (dg *DocumentGrid) Validate() error {
+	switch dg.Type {
+	case "", "default", "lines", "linesAndChars", "snapToChars":
+	default:
+		return fmt.Errorf("invalid document grid type: %s", dg.Type)
+	}
+	if dg.LinePitch < 0 {
+		return fmt.Errorf("document grid line pitch must not be negative: %d", dg.LinePitch)
+	}
+	return nil
+}
+
+// SetDocGrid sets the document grid; linePitch is the line pitch in twips and charSpace the extra character pitch
+func (sp *SectionProperties) SetDocGrid(gridType string, linePitch, charSpace int) error {
+	grid := &DocumentGrid{Type: gridType, LinePitch: linePitch, CharSpace: charSpace}
+	if err := grid.Validate(); err != nil {
+		return err
+	}
+	sp.DocGrid = grid
+	return nil
+}
+
+// XML generates the <w:sectPr> element
+func (sp *SectionProperties) XML() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(`<w:sectPr>`)
+
+	for _, ref := range sp.Headers {
+		fmt.Fprintf(&buf, `<w:headerReference w:type="%s" r:id="%s"/>`, ref.Type, ref.RelationshipID)
+	}
+	for _, ref := range sp.Footers {
+		fmt.Fprintf(&buf, `<w:footerReference w:type="%s" r:id="%s"/>`, ref.Type, ref.RelationshipID)
+	}
+
+	if fs := sp.Footnotes; fs != nil {
+		if err := fs.Validate(); err != nil {
+			return nil, err
+		}
+		fs.xml(&buf, "footnotePr")
+	}
+
+	if es := sp.Endnotes; es != nil {
+		if err := es.ValidateEndnotes(); err != nil {
+			return nil, err
+		}
+		es.xml(&buf, "endnotePr")
+	}
+
+	if sp.Type != "" {
+		fmt.Fprintf(&buf, `<w:type w:val="%s"/>`, sp.Type)
+	}
+
+	if ps := sp.PageSize; ps != nil {
+		if ps.Width <= 0 || ps.Height <= 0 {
+			return nil, fmt.Errorf("invalid page size %dx%d", ps.Width, ps.Height)
+		}
+		fmt.Fprintf(&buf, `<w:pgSz w:w="%d" w:h="%d"`, ps.Width, ps.Height)
+		if ps.Orientation == "landscape" {
+			buf.WriteString(` w:orient="landscape"`)
+		}
+		if ps.Code > 0 {
+			fmt.Fprintf(&buf, ` w:code="%d"`, ps.Code)
+		}
+		buf.WriteString(`/>`)
+	}
+
+	if pm := sp.PageMargins; pm != nil {
+		header, footer := pm.Header, pm.Footer
+		if sp.HeaderDistance > 0 {
+			header = sp.HeaderDistance
+		}
+		if sp.FooterDistance > 0 {
+			footer = sp.FooterDistance
+		}
+		fmt.Fprintf(&buf, `<w:pgMar w:top="%d" w:right="%d" w:bottom="%d" w:left="%d" w:header="%d" w:footer="%d" w:gutter="%d"/>`,
+			pm.Top, pm.Right, pm.Bottom, pm.Left, header, footer, pm.Gutter)
+	}
+
+	if pn := sp.PageNumbering; pn != nil {
+		if err := pn.Validate(); err != nil {
+			return nil, err
+		}
+		buf.WriteString(`<w:pgNumType`)
+		if pn.Format != "" {
+			fmt.Fprintf(&buf, ` w:fmt="%s"`, pn.Format)
+		}
+		if pn.Start > 0 {
+			fmt.Fprintf(&buf, ` w:start="%d"`, pn.Start)
+		}
+		if pn.ChapterStyle != "" {
+			fmt.Fprintf(&buf, ` w:chapStyle="%s"`, pn.ChapterStyle)
+		}
+		if pn.ChapterSep != "" {
+			fmt.Fprintf(&buf, ` w:chapSep="%s"`, pn.ChapterSep)
+		}
+		buf.WriteString(`/>`)
+	}
+
+	if sp.VerticalAlign != "" {
+		align, ok := verticalJc(sp.VerticalAlign)
+		if !ok {
+			return nil, fmt.Errorf("invalid section vertical alignment: %s", sp.VerticalAlign)
+		}
+		fmt.Fprintf(&buf, `<w:vAlign w:val="%s"/>`, align)
+	}
+
+	if sp.NoEndnote {
+		buf.WriteString(`<w:noEndnote/>`)
+	}
+
+	if sp.TitlePage {
+		buf.WriteString(`<w:titlePg/>`)
+	}
+
+	if dg := sp.DocGrid; dg != nil {
+		if err := dg.Validate(); err != nil {
+			return nil, err
+		}
+		buf.WriteString(`<w:docGrid`)
+		if dg.Type != "" {
+			fmt.Fprintf(&buf, ` w:type="%s"`, dg.Type)
+		}
+		if dg.LinePitch > 0 {
+			fmt.Fprintf(&buf, ` w:linePitch="%d"`, dg.LinePitch)
+		}
+		if dg.CharSpace != 0 {
+			fmt.Fprintf(&buf, ` w:charSpace="%d"`, dg.CharSpace)
+		}
+		buf.WriteString(`/>`)
+	}
+
+	buf.WriteString(`</w:sectPr>`)
+	return buf.Bytes(), nil
+}
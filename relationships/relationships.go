@@ -2,6 +2,7 @@
 package relationships
 
 import (
+	"crypto/sha256"
 	"encoding/xml"
 	"fmt"
 	"path"
@@ -52,6 +53,9 @@ const (
 	TypeDiagram        = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/diagramData"
 	TypeCustomXML      = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/customXml"
 	TypeCustomXMLProps = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/customXmlProps"
+	TypeAltChunk       = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/aFChunk"
+	TypeOLEObject      = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/oleObject"
+	TypePackage        = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/package"
 
 	// Package relationships
 	TypeCoreProperties   = "http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties"
@@ -174,6 +178,49 @@ func (r *Relationships) AddImage(filename string) *Relationship {
 	return r.AddDocumentRelationship(TypeImage, target, TargetModeInternal)
 }
 
+// AddImageData adds an image relationship named after a hash of the image content,
+// so identical images share one relationship and media part
+func (r *Relationships) AddImageData(data []byte, extension string) *Relationship {
+	return r.AddImageHash(sha256.Sum256(data), extension)
+}
+
+// AddImageHash adds an image relationship for content with the given SHA-256 hash.
+// An image with the same content is reused whatever its extension, so identical
+// bytes are stored once.
+func (r *Relationships) AddImageHash(sum [sha256.Size]byte, extension string) *Relationship {
+	prefix := "media/" + ImageFileName(sum, "") // "media/image-<hash>."
+	for _, rel := range r.GetByType(TypeImage) {
+		if rel.TargetMode != TargetModeExternal && strings.HasPrefix(rel.Target, prefix) {
+			return rel
+		}
+	}
+	return r.AddImage(ImageFileName(sum, extension))
+}
+
+// AddExternalImage adds a relationship to an image that is linked by URL instead of embedded
+func (r *Relationships) AddExternalImage(url string) *Relationship {
+	for _, rel := range r.GetByType(TypeImage) {
+		if rel.TargetMode == TargetModeExternal && rel.Target == url {
+			return rel
+		}
+	}
+
+	// Keep the external lookup for hyperlinks; a linked image must not be reused as a link target
+	prev, hadPrev := r.external[url]
+	rel := r.AddDocumentRelationship(TypeImage, url, TargetModeExternal)
+	if hadPrev {
+		r.external[url] = prev
+	} else {
+		delete(r.external, url)
+	}
+	return rel
+}
+
+// ImageFileName returns the media file name for an image with the given SHA-256 content hash
+func ImageFileName(sum [sha256.Size]byte, extension string) string {
+	return fmt.Sprintf("image-%x.%s", sum[:8], extension)
+}
+
 // AddHyperlink adds a hyperlink relationship
 func (r *Relationships) AddHyperlink(url string) *Relationship {
 	// Check if already exists
@@ -204,6 +251,21 @@ func (r *Relationships) AddCustomXML(xmlFile string) *Relationship {
 	return r.AddDocumentRelationship(TypeCustomXML, xmlFile, TargetModeInternal)
 }
 
+// AddAltChunk adds an alternative format import (altChunk) relationship
+func (r *Relationships) AddAltChunk(chunkFile string) *Relationship {
+	return r.AddDocumentRelationship(TypeAltChunk, chunkFile, TargetModeInternal)
+}
+
+// AddFootnotes adds the footnotes part relationship
+func (r *Relationships) AddFootnotes(footnotesFile string) *Relationship {
+	return r.AddDocumentRelationship(TypeFootnotes, footnotesFile, TargetModeInternal)
+}
+
+// AddEmbedding adds an embedded object relationship; relType is TypePackage or TypeOLEObject
+func (r *Relationships) AddEmbedding(relType, embeddingFile string) *Relationship {
+	return r.AddDocumentRelationship(relType, embeddingFile, TargetModeInternal)
+}
+
 // GetByID returns a relationship by ID
 func (r *Relationships) GetByID(id string) *Relationship {
 	return r.items[id]
@@ -0,0 +1,88 @@
+package mbadocx
+
+import "github.com/didikprabowo/mbadocx/elements"
+
+// textReplacer is implemented by body elements and parts, such as headers, that support find/replace
+type textReplacer interface {
+	ReplaceText(old, new string) int
+	ReplaceTextFormatted(old, new string, format func(*elements.Run)) int
+}
+
+// ReplaceText replaces every occurrence of old with new in all paragraphs and
+// table cells of the document, its headers, footers and footnotes, keeping the
+// formatting of the surrounding text.
+//
+// Parameters:
+//   - old: The text to find (e.g., a "{{NAME}}" placeholder); empty matches nothing
+//   - new: The replacement text
+//
+// Returns:
+//   - int: The number of replacements made
+//
+// Example:
+//
+//	doc.AddParagraph().AddText("Dear {{NAME}},")
+//	doc.ReplaceText("{{NAME}}", "Jane")
+//
+// Note: Matching is done per run, so a placeholder is only found when it was
+// added with uniform formatting (as a single AddText call, for example).
+func (d *Document) ReplaceText(old, new string) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	count := 0
+	for _, r := range d.textReplacers() {
+		count += r.ReplaceText(old, new)
+	}
+	return count
+}
+
+// ReplaceTextFormatted replaces every occurrence of old with new and formats
+// the replacement, in the body, headers, footers and footnotes. Each
+// replacement gets its own run that starts with the formatting of the text it
+// replaces; format is then applied on top of it.
+//
+// The replacement text is a parameter so a placeholder can be filled and
+// formatted in one call; to only format existing text, pass it as both old
+// and new.
+//
+// Parameters:
+//   - old: The text to find; empty matches nothing
+//   - new: The replacement text
+//   - format: Callback applied to each replacement run. May be nil.
+//
+// Returns:
+//   - int: The number of replacements made
+//
+// Example:
+//
+//	doc.AddParagraph().AddText("Status: {{WARNING}}")
+//	doc.ReplaceTextFormatted("{{WARNING}}", "Payment overdue", func(r *elements.Run) {
+//	    r.SetBold(true).SetColor("FF0000")
+//	})
+func (d *Document) ReplaceTextFormatted(old, new string, format func(*elements.Run)) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	count := 0
+	for _, r := range d.textReplacers() {
+		count += r.ReplaceTextFormatted(old, new, format)
+	}
+	return count
+}
+
+// textReplacers returns the body elements and parts whose text can be replaced
+func (d *Document) textReplacers() []textReplacer {
+	var replacers []textReplacer
+	for _, el := range d.body.GetElements() {
+		if r, ok := el.(textReplacer); ok {
+			replacers = append(replacers, r)
+		}
+	}
+	for _, media := range d.media.Media {
+		if r, ok := media.(textReplacer); ok {
+			replacers = append(replacers, r)
+		}
+	}
+	return replacers
+}
@@ -0,0 +1,338 @@
+package mbadocx
+
+import (
+	"fmt"
+
+	"github.com/didikprabowo/mbadocx/elements"
+	"github.com/didikprabowo/mbadocx/properties"
+	"github.com/didikprabowo/mbadocx/units"
+)
+
+// SetPaperSize sets the paper size of the document pages.
+//
+// The page width, height and paper code are written to <w:pgSz> in the final
+// section. The current orientation is kept, so a landscape document stays
+// landscape on the new paper.
+//
+// Parameters:
+//   - name: Paper name, case-insensitive: "A4", "Letter", "Legal", "A3" or "A5"
+//
+// Returns:
+//   - error: An error if the document has been closed or the paper name is unknown
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	if err := doc.SetPaperSize("Legal"); err != nil { // 8.5 x 14 inch pages
+//	    log.Fatal(err)
+//	}
+func (d *Document) SetPaperSize(name string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	size, ok := properties.PaperSize(name)
+	if !ok {
+		return fmt.Errorf("unknown paper size %q (supported: A3, A4, A5, Letter, Legal)", name)
+	}
+
+	if current := d.section.PageSize; current != nil && current.Orientation == "landscape" {
+		size.Width, size.Height = size.Height, size.Width
+		size.Orientation = "landscape"
+	}
+
+	d.section.PageSize = &size
+	return nil
+}
+
+// SetLandscape switches the pages between landscape and portrait orientation.
+//
+// The page width and height are swapped so landscape pages are wider than
+// tall, <w:pgSz w:orient="landscape"/> is written, and the page margins are
+// rotated with the page the way Word does. Header and footer distances are
+// unchanged. Calling it with the current orientation does nothing.
+//
+// Parameters:
+//   - landscape: true for landscape pages, false for portrait
+//
+// Returns:
+//   - error: An error if the document has been closed
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	doc.SetPaperSize("A4")
+//	if err := doc.SetLandscape(true); err != nil { // 297 x 210 mm pages
+//	    log.Fatal(err)
+//	}
+func (d *Document) SetLandscape(landscape bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	if d.section.PageSize == nil {
+		size, _ := properties.PaperSize("Letter")
+		d.section.PageSize = &size
+	}
+	d.section.SetLandscape(landscape)
+	return nil
+}
+
+// SetSectionVerticalAlign sets how the page content is aligned vertically
+// between the top and bottom margins.
+//
+// The value is written to <w:vAlign> in the final section, so a title page
+// set to "center" has its content centered on the page.
+//
+// Parameters:
+//   - v: "top" (default), "center", "bottom" or "both" (spread lines over the page; "justify" is accepted too)
+//
+// Returns:
+//   - error: An error if the document has been closed or the value is not recognised
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	doc.AddHeading("Annual Report 2026", 1)
+//	if err := doc.SetSectionVerticalAlign("center"); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) SetSectionVerticalAlign(v string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	return d.section.SetVerticalAlign(v)
+}
+
+// SetHeaderFooterDistance sets how far the header sits from the top edge of
+// the page and the footer from the bottom edge.
+//
+// The distances are written to the w:header and w:footer attributes of
+// <w:pgMar> in the final section. The defaults are 0.5 inch.
+//
+// Parameters:
+//   - header: Distance from the page top to the header (e.g., units.Inches(0.3))
+//   - footer: Distance from the page bottom to the footer
+//
+// Returns:
+//   - error: An error if the document has been closed or a distance is negative
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	if err := doc.SetHeaderFooterDistance(units.Inches(0.3), units.Cm(1)); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) SetHeaderFooterDistance(header, footer units.Length) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	return d.section.SetHeaderFooterDistance(int(header.Twips()), int(footer.Twips()))
+}
+
+// SetDifferentFirstPage sets whether the first page of the section has its
+// own header and footer, separate from the ones on later pages.
+//
+// When enabled, <w:titlePg/> is written to the current section. A first page
+// without a first-page header of its own is left without a header, which is
+// the usual layout for a cover page. The headers and footers themselves are
+// added separately with AddHeader and AddFooter.
+//
+// Parameters:
+//   - different: true to give the first page its own header and footer
+//
+// Returns:
+//   - error: An error if the document has been closed
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	doc.AddHeading("Annual Report 2026", 1)
+//	if err := doc.SetDifferentFirstPage(true); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) SetDifferentFirstPage(different bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	d.section.TitlePage = different
+	return nil
+}
+
+// SetDocGrid sets the document grid used to lay out East Asian text.
+//
+// The grid is written to <w:docGrid> in the final section. With "lines" every
+// line snaps to the line pitch; with "linesAndChars" or "snapToChars"
+// characters are also aligned to the character grid, widened by charSpace.
+//
+// Parameters:
+//   - gridType: "default" (no grid), "lines", "linesAndChars" or "snapToChars"
+//   - linePitch: Distance between grid lines (e.g., units.Points(18))
+//   - charSpace: Extra character pitch in 1/4096 of a point; 0 keeps the font's pitch
+//
+// Returns:
+//   - error: An error if the document has been closed or the grid type is not recognised
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	if err := doc.SetDocGrid("linesAndChars", units.Points(18), 4096); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) SetDocGrid(gridType string, linePitch units.Length, charSpace int) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	return d.section.SetDocGrid(gridType, int(linePitch.Twips()), charSpace)
+}
+
+// AddSectionBreak ends the current section and starts a new one.
+//
+// The page setup built so far is written to <w:sectPr> in the properties of a
+// new empty paragraph, which closes the section. The new section starts with
+// a copy of the same page setup, so later calls such as SetLandscape or
+// SetFootnoteNumbering only change the pages after the break.
+//
+// Parameters:
+//   - breakType: How the new section starts: "nextPage" (default when empty), "continuous", "evenPage", "oddPage" or "nextColumn"
+//
+// Returns:
+//   - error: An error if the document has been closed or the break type is not recognised
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	doc.AddParagraph().AddText("Part one")
+//	if err := doc.AddSectionBreak("nextPage"); err != nil {
+//	    log.Fatal(err)
+//	}
+//	doc.SetFootnoteNumbering("decimal", "eachSect") // Footnotes in part two start again at 1
+func (d *Document) AddSectionBreak(breakType string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	switch breakType {
+	case "":
+		breakType = "nextPage"
+	case "nextPage", "continuous", "evenPage", "oddPage", "nextColumn":
+	default:
+		return fmt.Errorf("invalid section break type %q (supported: nextPage, continuous, evenPage, oddPage, nextColumn)", breakType)
+	}
+
+	p := elements.NewParagraph(d)
+	p.Properties.SectionProperties = d.section.Clone()
+	d.body.AddElement(p)
+
+	next := d.section.Clone()
+	next.Type = breakType
+	// The new section keeps the page number format but continues the numbering
+	if next.PageNumbering != nil {
+		next.PageNumbering.Start = 0
+	}
+	d.section = next
+	return nil
+}
+
+// SetSuppressEndnotes sets whether endnotes are kept out of the current
+// section.
+//
+// When enabled, <w:noEndnote/> is written to the section, and endnotes that
+// would be placed at the end of it move to the end of the next section.
+//
+// Parameters:
+//   - suppress: true to suppress endnotes in the current section
+//
+// Returns:
+//   - error: An error if the document has been closed
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	doc.AddParagraph().AddText("Front matter")
+//	doc.SetSuppressEndnotes(true)
+//	doc.AddSectionBreak("nextPage")
+func (d *Document) SetSuppressEndnotes(suppress bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	d.section.NoEndnote = suppress
+	return nil
+}
+
+// SetPageNumbering sets how the pages of the current section are numbered.
+//
+// The format and start are written to <w:pgNumType> of the section, and
+// page number fields added with Paragraph.AddPageNumberField to a header or
+// footer show them. Combined with AddSectionBreak, each section can switch
+// format and restart its numbering, so front matter is numbered i, ii, iii
+// and the body 1, 2, 3.
+//
+// Parameters:
+//   - format: "decimal", "lowerRoman", "upperRoman", "lowerLetter" or "upperLetter"; empty keeps Word's default (decimal)
+//   - start: Number of the first page of the section; 0 continues from the previous section
+//
+// Returns:
+//   - error: An error if the document has been closed or a value is not recognised
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	footer, _ := doc.AddFooter("default") // Kept by the sections that follow
+//	footer.AddParagraph().SetAlignment(elements.AlignmentCenter).AddPageNumberField()
+//
+//	doc.SetPageNumbering("lowerRoman", 1) // Front matter: i, ii, iii
+//	doc.AddParagraph().AddText("Preface")
+//	doc.AddSectionBreak("nextPage")
+//	doc.SetPageNumbering("decimal", 1) // Body: 1, 2, 3
+func (d *Document) SetPageNumbering(format string, start int) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	numbering := properties.PageNumbering{}
+	if d.section.PageNumbering != nil {
+		numbering = *d.section.PageNumbering
+	}
+	numbering.Format = format
+	numbering.Start = start
+
+	if err := numbering.Validate(); err != nil {
+		return err
+	}
+
+	d.section.PageNumbering = &numbering
+	return nil
+}
@@ -0,0 +1,375 @@
+package mbadocx
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/didikprabowo/mbadocx/properties"
+	"github.com/didikprabowo/mbadocx/settings"
+)
+
+// SetPageColor sets the page background color of the document.
+// The color is written as <w:background> in document.xml and the
+// displayBackgroundShape setting is enabled so Word renders it.
+//
+// Parameters:
+//   - hex: A 6-digit RGB hex color such as "FFF2CC". A leading "#" is accepted.
+//     An empty string removes the page color.
+//
+// Returns:
+//   - error: An error if the color is not a valid 6-digit hex value
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	if err := doc.SetPageColor("#FFF2CC"); err != nil {
+//	    log.Fatal(err)
+//	}
+//
+// Note: Word only shows page colors in Print Layout and Web Layout views,
+// and does not print them unless "Print background colors" is enabled.
+func (d *Document) SetPageColor(hex string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	color := strings.ToUpper(strings.TrimPrefix(hex, "#"))
+	if color == "" {
+		d.settings.PageColor = ""
+		d.settings.DisplayBackgroundShape = false
+		return nil
+	}
+
+	if !isHexColor(color) {
+		return fmt.Errorf("invalid page color %q: expected 6-digit hex value", hex)
+	}
+
+	d.settings.PageColor = color
+	d.settings.DisplayBackgroundShape = true
+	return nil
+}
+
+// SetDefaultTabStop sets the interval between the default tab stops of the document.
+// Paragraphs without custom tab stops (see Paragraph.SetTabs) advance to the next
+// multiple of this distance when a tab is typed or inserted with Run.AddTab.
+//
+// Parameters:
+//   - twips: The tab interval in twips (1440 twips = 1 inch, 720 = 0.5 inch).
+//     Word's default is 720.
+//
+// Returns:
+//   - error: An error if the interval is not positive
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//
+//	// Tab every inch instead of every half inch
+//	if err := doc.SetDefaultTabStop(1440); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) SetDefaultTabStop(twips int) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	if twips <= 0 {
+		return fmt.Errorf("default tab stop must be positive: %d", twips)
+	}
+
+	d.settings.DefaultTabStop = twips
+	return nil
+}
+
+// EnableAutoHyphenation turns on automatic hyphenation for the whole document.
+// Word hyphenates words at line ends using the hyphenation rules of the text language.
+//
+// Returns:
+//   - error: An error if the document has been closed
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	if err := doc.EnableAutoHyphenation(); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) EnableAutoHyphenation() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	d.settings.AutoHyphenation = true
+	return nil
+}
+
+// EnableUpdateFieldsOnOpen asks Word to update all fields when the document is opened.
+// Use this for documents containing a table of contents, page references or other
+// fields whose results are calculated by Word. Word prompts the user before updating.
+//
+// Returns:
+//   - error: An error if the document has been closed
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	if err := doc.EnableUpdateFieldsOnOpen(); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) EnableUpdateFieldsOnOpen() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	d.settings.UpdateFieldsOnOpen = true
+	return nil
+}
+
+// SetEastAsianLineBreaking configures kinsoku (East Asian line breaking) rules.
+// Word keeps the given characters from ending or starting a line, and strict mode
+// applies the strict first/last character rules of the language. The language is
+// also recorded as the East Asian theme font language.
+//
+// Parameters:
+//   - lang: Language of the rules, such as "ja-JP" or "zh-CN"
+//   - noBreakAfter: Characters that may not end a line (e.g. opening brackets); empty uses Word's defaults
+//   - noBreakBefore: Characters that may not start a line (e.g. closing punctuation); empty uses Word's defaults
+//   - strict: Enable strict first and last character rules
+//
+// Returns:
+//   - error: An error if the document has been closed or lang is empty while characters are given
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	if err := doc.SetEastAsianLineBreaking("ja-JP", "([{「『", ")]}、。」』", true); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) SetEastAsianLineBreaking(lang, noBreakAfter, noBreakBefore string, strict bool) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	if lang == "" && (noBreakAfter != "" || noBreakBefore != "") {
+		return fmt.Errorf("kinsoku characters require a language")
+	}
+
+	d.settings.EastAsianLanguage = lang
+	d.settings.StrictFirstAndLastChars = strict
+	d.settings.NoLineBreaksAfter = nil
+	d.settings.NoLineBreaksBefore = nil
+
+	if noBreakAfter != "" {
+		d.settings.NoLineBreaksAfter = &settings.Kinsoku{Lang: lang, Characters: noBreakAfter}
+	}
+	if noBreakBefore != "" {
+		d.settings.NoLineBreaksBefore = &settings.Kinsoku{Lang: lang, Characters: noBreakBefore}
+	}
+	return nil
+}
+
+// SetProofState records whether spelling and grammar have already been checked.
+// "clean" tells Word the text was checked and needs no re-check; "dirty" asks Word
+// to check it again. Documents omit the proofing state unless it is set.
+//
+// Parameters:
+//   - spelling: "clean", "dirty", or "" to leave spelling state unset
+//   - grammar: "clean", "dirty", or "" to leave grammar state unset
+//
+// Returns:
+//   - error: An error if the document has been closed or a state is not recognized
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	if err := doc.SetProofState("clean", "clean"); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) SetProofState(spelling, grammar string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	for _, state := range []string{spelling, grammar} {
+		if state != "" && state != "clean" && state != "dirty" {
+			return fmt.Errorf("invalid proof state %q: expected \"clean\" or \"dirty\"", state)
+		}
+	}
+
+	d.settings.SpellingState = spelling
+	d.settings.GrammarState = grammar
+	return nil
+}
+
+// SetLanguage sets the language of the whole document.
+//
+// The language is written to the default paragraph style, which every run
+// inherits for spelling and grammar checking, to the theme font language in
+// settings.xml and to the dc:language core property. Runs with their own
+// language (Run.SetLanguage) keep it.
+//
+// Parameters:
+//   - lang: BCP 47 language tag such as "en-US", "de-DE" or "fr-FR"
+//
+// Returns:
+//   - error: An error if the document has been closed or lang is empty
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	if err := doc.SetLanguage("de-DE"); err != nil {
+//	    log.Fatal(err)
+//	}
+//	doc.AddParagraph().AddText("Guten Tag") // checked with the German dictionary
+func (d *Document) SetLanguage(lang string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	if lang == "" {
+		return fmt.Errorf("language must not be empty")
+	}
+
+	d.styles.SetLanguage(lang)
+	d.settings.Language = lang
+	d.metadata.Language = lang
+	return nil
+}
+
+// LoadSettings replaces the document settings with those read from the
+// word/settings.xml part of an existing document or template, such as its
+// zoom, default tab stop, hyphenation, proofing and compatibility settings.
+//
+// Settings not modelled by this package are ignored. The page color is kept,
+// because Word stores it in document.xml rather than settings.xml. The page
+// size and margins are stored there too; load them with LoadPageSetup.
+//
+// Parameters:
+//   - r: Reader for the settings.xml content
+//
+// Returns:
+//   - error: An error if the document has been closed or the XML cannot be parsed
+//
+// Example:
+//
+//	zr, _ := zip.OpenReader("template.docx")
+//	defer zr.Close()
+//	f, _ := zr.Open("word/settings.xml")
+//	defer f.Close()
+//
+//	doc := mbadocx.New()
+//	if err := doc.LoadSettings(f); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) LoadSettings(r io.Reader) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("failed to read settings: %w", err)
+	}
+
+	loaded, err := settings.Parse(data)
+	if err != nil {
+		return err
+	}
+
+	loaded.PageColor = d.settings.PageColor
+	d.settings = loaded
+	return nil
+}
+
+// LoadPageSetup sets the page size and margins of the current section to
+// those of the last section in the word/document.xml part of an existing
+// document or template, so a document based on an A4 template is A4 too.
+//
+// Values the part does not set keep their current values.
+//
+// Parameters:
+//   - r: Reader for the document.xml content
+//
+// Returns:
+//   - error: An error if the document has been closed or the XML cannot be parsed
+//
+// Example:
+//
+//	zr, _ := zip.OpenReader("template.docx")
+//	defer zr.Close()
+//	f, _ := zr.Open("word/document.xml")
+//	defer f.Close()
+//
+//	doc := mbadocx.New()
+//	if err := doc.LoadPageSetup(f); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) LoadPageSetup(r io.Reader) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("failed to read document: %w", err)
+	}
+
+	size, margins, err := properties.ParsePageSetup(data)
+	if err != nil {
+		return err
+	}
+
+	if size != nil {
+		if size.Width <= 0 || size.Height <= 0 {
+			return fmt.Errorf("invalid page size %dx%d", size.Width, size.Height)
+		}
+		d.section.PageSize = size
+	}
+	if margins != nil {
+		// The loaded header and footer distances replace any set separately
+		d.section.PageMargins = margins
+		d.section.HeaderDistance = 0
+		d.section.FooterDistance = 0
+	}
+	return nil
+}
+
+// isHexColor reports whether s is a 6-digit RGB hex value
+func isHexColor(s string) bool {
+	if len(s) != 6 {
+		return false
+	}
+	for _, c := range s {
+		if !strings.ContainsRune("0123456789ABCDEFabcdef", c) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,106 @@
+package settings
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// wordNamespace is the WordprocessingML main namespace used by settings.xml
+const wordNamespace = "http://schemas.openxmlformats.org/wordprocessingml/2006/main"
+
+// Parse reads word/settings.xml from an existing document or template.
+// Settings this package does not model are ignored; missing ones keep their defaults.
+func Parse(data []byte) (*Settings, error) {
+	s := NewDefaultSettings()
+	s.Zoom = 0
+	s.Language = ""
+	s.CompatibilityMode = 0
+
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	inCompat := false
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parsing settings.xml: %w", err)
+		}
+
+		switch el := tok.(type) {
+		case xml.EndElement:
+			if el.Name.Space == wordNamespace && el.Name.Local == "compat" {
+				inCompat = false
+			}
+		case xml.StartElement:
+			if el.Name.Space != wordNamespace {
+				continue
+			}
+			switch el.Name.Local {
+			case "compat":
+				inCompat = true
+			case "zoom":
+				s.Zoom = atoi(attr(el, "percent"))
+			case "defaultTabStop":
+				s.DefaultTabStop = atoi(attr(el, "val"))
+			case "displayBackgroundShape":
+				s.DisplayBackgroundShape = onOff(el)
+			case "autoHyphenation":
+				s.AutoHyphenation = onOff(el)
+			case "updateFields":
+				s.UpdateFieldsOnOpen = onOff(el)
+			case "proofState":
+				s.SpellingState = attr(el, "spelling")
+				s.GrammarState = attr(el, "grammar")
+			case "strictFirstAndLastChars":
+				s.StrictFirstAndLastChars = onOff(el)
+			case "noLineBreaksAfter":
+				s.NoLineBreaksAfter = &Kinsoku{Lang: attr(el, "lang"), Characters: attr(el, "val")}
+			case "noLineBreaksBefore":
+				s.NoLineBreaksBefore = &Kinsoku{Lang: attr(el, "lang"), Characters: attr(el, "val")}
+			case "themeFontLang":
+				s.Language = attr(el, "val")
+				s.EastAsianLanguage = attr(el, "eastAsia")
+			case "adjustLineHeightInTable":
+				s.AdjustLineHeightInTable = inCompat && onOff(el)
+			case "doNotBreakWrappedTables":
+				s.DoNotBreakWrappedTables = inCompat && onOff(el)
+			case "compatSetting":
+				if attr(el, "name") == "compatibilityMode" {
+					s.CompatibilityMode = atoi(attr(el, "val"))
+				}
+			}
+		}
+	}
+
+	return s, nil
+}
+
+// attr returns the value of a w: attribute, or "" if absent
+func attr(el xml.StartElement, local string) string {
+	for _, a := range el.Attr {
+		if a.Name.Local == local && (a.Name.Space == wordNamespace || a.Name.Space == "") {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// onOff reads a CT_OnOff element, which is on unless w:val says otherwise
+func onOff(el xml.StartElement) bool {
+	switch attr(el, "val") {
+	case "0", "false", "off":
+		return false
+	default:
+		return true
+	}
+}
+
+// atoi parses an integer attribute, returning 0 when it is missing or invalid
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
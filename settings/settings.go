@@ -0,0 +1,45 @@
+package settings
+
+// Settings holds document-wide settings written to word/settings.xml
+type Settings struct {
+	Zoom                   int    // Zoom percentage used when the document is opened
+	DefaultTabStop         int    // Default tab stop interval in twips
+	DisplayBackgroundShape bool   // Show the page background in Word
+	PageColor              string // Page background color in hex (e.g. "FFF2CC"), empty for none
+	CompatibilityMode      int    // Word compatibility mode (15 = Word 2013 and later)
+	Language               string // Theme font language (e.g. "en-US")
+	AutoHyphenation        bool   // Hyphenate text automatically
+	UpdateFieldsOnOpen     bool   // Ask Word to update fields (TOC, page refs) when the document is opened
+	SpellingState          string // Proofing state of spelling ("clean" or "dirty"), empty to omit
+	GrammarState           string // Proofing state of grammar ("clean" or "dirty"), empty to omit
+
+	// East Asian line breaking (kinsoku)
+	EastAsianLanguage       string   // East Asian theme font language (e.g. "ja-JP")
+	StrictFirstAndLastChars bool     // Use strict line breaking rules for the first and last characters of a line
+	NoLineBreaksAfter       *Kinsoku // Characters that may not end a line
+	NoLineBreaksBefore      *Kinsoku // Characters that may not start a line
+
+	// Compatibility flags written to w:compat
+	AdjustLineHeightInTable bool // Add document grid line pitch to lines in table cells
+	DoNotBreakWrappedTables bool // Do not allow text-wrapped (floating) tables to break across pages
+}
+
+// Kinsoku holds a custom set of line breaking characters for an East Asian language
+type Kinsoku struct {
+	Lang       string // Language the rule applies to (e.g. "ja-JP", "zh-CN")
+	Characters string // Characters the rule applies to
+}
+
+// NewDefaultSettings creates default document settings
+func NewDefaultSettings() *Settings {
+	return &Settings{
+		Zoom:              100,
+		DefaultTabStop:    720,
+		CompatibilityMode: 15,
+		Language:          "en-US",
+	}
+}
+
+func (s *Settings) Get() *Settings {
+	return s
+}
@@ -0,0 +1,50 @@
+package mbadocx
+
+import (
+	"fmt"
+
+	"github.com/didikprabowo/mbadocx/elements"
+)
+
+// AddSignatureLine inserts an Office signature line at the end of the document.
+// Word shows it as a signature line with the suggested signer and title
+// beneath it; double-clicking it lets the recipient sign the document. The
+// line is wrapped in a locked content control tagged "signature" so it is
+// easy to locate.
+//
+// Note: The document is not cryptographically signed when it is created;
+// signing is done by the recipient in Word.
+//
+// Parameters:
+//   - signer: Suggested signer name shown under the line
+//   - title: Suggested signer title (e.g., "Chief Executive Officer"), may be empty
+//
+// Returns:
+//   - *elements.SignatureLine: The inserted signature line
+//   - error: An error if the document has been closed or the placeholder picture cannot be added
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	doc.AddParagraph().AddText("Agreed and accepted:")
+//	if _, err := doc.AddSignatureLine("Jane Doe", "Managing Director"); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) AddSignatureLine(signer, title string) (*elements.SignatureLine, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return nil, fmt.Errorf("document has been closed")
+	}
+
+	picture, err := elements.NewImageFromBytes(d, elements.SignatureLinePicture(), "signature-line", "image/png")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature line picture: %w", err)
+	}
+	d.media.AddMedia(picture)
+
+	line := elements.NewSignatureLine(signer, title, picture)
+	d.body.AddElement(line)
+	return line, nil
+}
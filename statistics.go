@@ -0,0 +1,55 @@
+package mbadocx
+
+import "github.com/didikprabowo/mbadocx/writer"
+
+// WordCount returns the number of words in the document body.
+// It uses the same counting rules as the Words value written to docProps/app.xml,
+// so the result matches the saved document without saving and reopening it.
+//
+// Returns:
+//   - int: The number of words, or 0 if the document has been closed
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	doc.AddParagraph().AddText("Hello brave new world")
+//	fmt.Println(doc.WordCount()) // 4
+func (d *Document) WordCount() int {
+	return d.statistics().Words
+}
+
+// ParagraphCount returns the number of top-level paragraphs in the document body,
+// matching the Paragraphs value written to docProps/app.xml.
+//
+// Returns:
+//   - int: The number of paragraphs, or 0 if the document has been closed
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	doc.AddParagraph().AddText("First")
+//	doc.AddParagraph().AddText("Second")
+//	fmt.Println(doc.ParagraphCount()) // 2
+func (d *Document) ParagraphCount() int {
+	return d.statistics().Paragraphs
+}
+
+// CharacterCount returns the number of non-whitespace characters in the document body,
+// matching the Characters value written to docProps/app.xml.
+//
+// Returns:
+//   - int: The number of characters, or 0 if the document has been closed
+func (d *Document) CharacterCount() int {
+	return d.statistics().Characters
+}
+
+// statistics computes the document statistics under a read lock
+func (d *Document) statistics() writer.Statistics {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.closed {
+		return writer.Statistics{}
+	}
+	return writer.CountStatistics(d)
+}
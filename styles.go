@@ -0,0 +1,64 @@
+package mbadocx
+
+import "fmt"
+
+// SetStyleLineSpacing sets the line spacing of a paragraph style such as "Normal" or "Heading1".
+//
+// The value is converted exactly like Paragraph.SetLineSpacing, so a style set to 1.5 and a
+// paragraph set to 1.5 produce the same spacing.
+//
+// Parameters:
+//   - styleID: ID of an existing style (e.g., "Normal", "Heading1")
+//   - spacing: Multiple of single spacing for "auto", or points for "exact"/"atLeast"
+//   - rule: "auto", "exact" or "atLeast"
+//
+// Returns:
+//   - error: If the document has been closed, the spacing is not positive or the style does not exist
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	if err := doc.SetStyleLineSpacing("Normal", 1.5, "auto"); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) SetStyleLineSpacing(styleID string, spacing float64, rule string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	return d.styles.SetLineSpacing(styleID, spacing, rule)
+}
+
+// SetDefaultParagraphSpacing sets the space before and after paragraphs in the default
+// paragraph style ("Normal"), so every paragraph without its own spacing inherits it.
+//
+// New paragraphs no longer carry their own spacing; they only override the style when
+// Paragraph.SetSpacing is called. Setting 0/0 removes the gap between paragraphs
+// document-wide.
+//
+// Parameters:
+//   - before: Space before each paragraph in points
+//   - after: Space after each paragraph in points
+//
+// Returns:
+//   - error: If the document has been closed or either value is negative
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	if err := doc.SetDefaultParagraphSpacing(0, 0); err != nil {
+//	    log.Fatal(err)
+//	}
+func (d *Document) SetDefaultParagraphSpacing(before, after float64) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return fmt.Errorf("document has been closed")
+	}
+
+	return d.styles.SetDefaultSpacing(before, after)
+}
@@ -2,6 +2,10 @@ package styles
 
 import (
 	"encoding/xml"
+	"fmt"
+	"strconv"
+
+	"github.com/didikprabowo/mbadocx/properties"
 )
 
 // Styles structure for defining heading styles
@@ -52,6 +56,7 @@ type QFormat struct{}
 type StylePPr struct {
 	KeepNext      *KeepNext      `xml:"w:keepNext,omitempty"`
 	KeepLines     *KeepLines     `xml:"w:keepLines,omitempty"`
+	NumPr         *NumPr         `xml:"w:numPr,omitempty"`
 	SpacingStyle  *SpacingStyle  `xml:"w:spacing,omitempty"`
 	OutlineLevel  *OutlineLevel  `xml:"w:outlineLvl,omitempty"`
 	Justification *Justification `xml:"w:jc,omitempty"`
@@ -68,6 +73,7 @@ type StyleRPr struct {
 	SizeCs    *Size      `xml:"w:szCs,omitempty"`
 	Color     *Color     `xml:"w:color,omitempty"`
 	Underline *Underline `xml:"w:u,omitempty"`
+	Lang      *Lang      `xml:"w:lang,omitempty"`
 }
 
 type KeepNext struct{}
@@ -82,6 +88,16 @@ type SpacingStyle struct {
 	LineRule string `xml:"w:lineRule,attr,omitempty"`
 }
 
+// NumPr links a paragraph style to a numbering instance and level
+type NumPr struct {
+	Ilvl  *NumVal `xml:"w:ilvl,omitempty"`
+	NumID NumVal  `xml:"w:numId"`
+}
+
+type NumVal struct {
+	Val string `xml:"w:val,attr"`
+}
+
 type OutlineLevel struct {
 	Val string `xml:"w:val,attr"`
 }
@@ -118,6 +134,12 @@ type Underline struct {
 	Val string `xml:"w:val,attr"`
 }
 
+type Lang struct {
+	Val      string `xml:"w:val,attr,omitempty"`
+	EastAsia string `xml:"w:eastAsia,attr,omitempty"`
+	Bidi     string `xml:"w:bidi,attr,omitempty"`
+}
+
 func normalStyle() Style {
 	return Style{
 		Type:    "paragraph",
@@ -126,7 +148,7 @@ func normalStyle() Style {
 		Name:    StyleName{Val: "Normal"},
 		StylePPr: &StylePPr{
 			SpacingStyle: &SpacingStyle{
-				// After:    "160",
+				After:    "160", // 8pt
 				Line:     "259",
 				LineRule: "auto",
 			},
@@ -139,6 +161,7 @@ func normalStyle() Style {
 			},
 			Size:   &Size{Val: "22"}, // 11pt
 			SizeCs: &Size{Val: "22"},
+			Lang:   &Lang{Val: "en-US"},
 		},
 	}
 }
@@ -266,6 +289,86 @@ func heading5Style() Style {
 	}
 }
 
+func heading6Style() Style {
+	return Style{
+		Type:    "paragraph",
+		StyleId: "Heading6",
+		Name:    StyleName{Val: "Heading 6"},
+		BasedOn: &StyleBasedOn{Val: "Normal"},
+		Next:    &StyleNext{Val: "Normal"},
+		StylePPr: &StylePPr{
+			SpacingStyle: &SpacingStyle{Before: "200", After: "80"},
+			OutlineLevel: &OutlineLevel{Val: "5"},
+		},
+		StyleRPr: &StyleRPr{
+			Italic:   &Italic{},
+			ItalicCs: &Italic{},
+			Size:     &Size{Val: "20"}, // 10pt
+			SizeCs:   &Size{Val: "20"},
+			Color:    &Color{Val: "1F3763"},
+		},
+	}
+}
+
+func heading7Style() Style {
+	return Style{
+		Type:    "paragraph",
+		StyleId: "Heading7",
+		Name:    StyleName{Val: "Heading 7"},
+		BasedOn: &StyleBasedOn{Val: "Normal"},
+		Next:    &StyleNext{Val: "Normal"},
+		StylePPr: &StylePPr{
+			SpacingStyle: &SpacingStyle{Before: "200", After: "80"},
+			OutlineLevel: &OutlineLevel{Val: "6"},
+		},
+		StyleRPr: &StyleRPr{
+			Size:   &Size{Val: "20"}, // 10pt
+			SizeCs: &Size{Val: "20"},
+			Color:  &Color{Val: "404040"},
+		},
+	}
+}
+
+func heading8Style() Style {
+	return Style{
+		Type:    "paragraph",
+		StyleId: "Heading8",
+		Name:    StyleName{Val: "Heading 8"},
+		BasedOn: &StyleBasedOn{Val: "Normal"},
+		Next:    &StyleNext{Val: "Normal"},
+		StylePPr: &StylePPr{
+			SpacingStyle: &SpacingStyle{Before: "160", After: "60"},
+			OutlineLevel: &OutlineLevel{Val: "7"},
+		},
+		StyleRPr: &StyleRPr{
+			Italic:   &Italic{},
+			ItalicCs: &Italic{},
+			Size:     &Size{Val: "18"}, // 9pt
+			SizeCs:   &Size{Val: "18"},
+			Color:    &Color{Val: "404040"},
+		},
+	}
+}
+
+func heading9Style() Style {
+	return Style{
+		Type:    "paragraph",
+		StyleId: "Heading9",
+		Name:    StyleName{Val: "Heading 9"},
+		BasedOn: &StyleBasedOn{Val: "Normal"},
+		Next:    &StyleNext{Val: "Normal"},
+		StylePPr: &StylePPr{
+			SpacingStyle: &SpacingStyle{Before: "160", After: "60"},
+			OutlineLevel: &OutlineLevel{Val: "8"},
+		},
+		StyleRPr: &StyleRPr{
+			Size:   &Size{Val: "18"}, // 9pt
+			SizeCs: &Size{Val: "18"},
+			Color:  &Color{Val: "595959"},
+		},
+	}
+}
+
 // NewDefaultStyles
 func NewDefaultStyles() *Styles {
 	styles := Styles{
@@ -284,6 +387,14 @@ func NewDefaultStyles() *Styles {
 			heading4Style(),
 			// Heading 5
 			heading5Style(),
+			// Heading 6
+			heading6Style(),
+			// Heading 7
+			heading7Style(),
+			// Heading 8
+			heading8Style(),
+			// Heading 9
+			heading9Style(),
 		},
 	}
 	return &styles
@@ -293,3 +404,175 @@ func NewDefaultStyles() *Styles {
 func (s *Styles) Get() *Styles {
 	return s
 }
+
+// SetLineSpacing sets the line spacing of a style using the same conversion as paragraph properties
+func (s *Styles) SetLineSpacing(styleID string, spacing float64, rule string) error {
+	if spacing <= 0 {
+		return fmt.Errorf("line spacing must be positive: %v", spacing)
+	}
+	for i := range s.Styles {
+		style := &s.Styles[i]
+		if style.StyleId != styleID {
+			continue
+		}
+		if style.StylePPr == nil {
+			style.StylePPr = &StylePPr{}
+		}
+		if style.StylePPr.SpacingStyle == nil {
+			style.StylePPr.SpacingStyle = &SpacingStyle{}
+		}
+		line, lineRule := properties.LineSpacingValue(spacing, rule)
+		style.StylePPr.SpacingStyle.Line = strconv.Itoa(line)
+		style.StylePPr.SpacingStyle.LineRule = lineRule
+		return nil
+	}
+	return fmt.Errorf("style not found: %s", styleID)
+}
+
+// SetSpacing sets the space before and after paragraphs of a style, in points
+func (s *Styles) SetSpacing(styleID string, before, after float64) error {
+	if before < 0 || after < 0 {
+		return fmt.Errorf("paragraph spacing must not be negative: %v, %v", before, after)
+	}
+	style := s.find(styleID)
+	if style == nil {
+		return fmt.Errorf("style not found: %s", styleID)
+	}
+	if style.StylePPr == nil {
+		style.StylePPr = &StylePPr{}
+	}
+	if style.StylePPr.SpacingStyle == nil {
+		style.StylePPr.SpacingStyle = &SpacingStyle{}
+	}
+	style.StylePPr.SpacingStyle.Before = strconv.Itoa(int(before * 20))
+	style.StylePPr.SpacingStyle.After = strconv.Itoa(int(after * 20))
+	return nil
+}
+
+// SetDefaultSpacing sets the paragraph spacing of the default paragraph style
+func (s *Styles) SetDefaultSpacing(before, after float64) error {
+	return s.SetSpacing(s.defaultParagraphStyleID(), before, after)
+}
+
+// SetNumbering links a paragraph style to a numbering instance, so every paragraph in the style is numbered at the level
+func (s *Styles) SetNumbering(styleID string, numID, level int) error {
+	if level < 0 || level > 8 {
+		return fmt.Errorf("numbering level must be between 0 and 8: %d", level)
+	}
+	style := s.find(styleID)
+	if style == nil {
+		return fmt.Errorf("style not found: %s", styleID)
+	}
+	if style.StylePPr == nil {
+		style.StylePPr = &StylePPr{}
+	}
+	numPr := &NumPr{NumID: NumVal{Val: strconv.Itoa(numID)}}
+	if level > 0 {
+		numPr.Ilvl = &NumVal{Val: strconv.Itoa(level)}
+	}
+	style.StylePPr.NumPr = numPr
+	return nil
+}
+
+// SetLanguage sets the proofing language of the default paragraph style, which all runs inherit unless overridden
+func (s *Styles) SetLanguage(lang string) {
+	style := s.find(s.defaultParagraphStyleID())
+	if style == nil {
+		return
+	}
+	if style.StyleRPr == nil {
+		style.StyleRPr = &StyleRPr{}
+	}
+	if style.StyleRPr.Lang == nil {
+		style.StyleRPr.Lang = &Lang{}
+	}
+	style.StyleRPr.Lang.Val = lang
+}
+
+// RunProperties resolves the run formatting of a style, following its basedOn
+// chain. The default paragraph style is used when styleID is empty.
+// It returns nil when the style does not exist.
+func (s *Styles) RunProperties(styleID string) *properties.RunProperties {
+	if styleID == "" {
+		styleID = s.defaultParagraphStyleID()
+	}
+
+	var chain []*Style
+	seen := make(map[string]bool)
+	for id := styleID; id != "" && !seen[id]; {
+		seen[id] = true
+		style := s.find(id)
+		if style == nil {
+			break
+		}
+		chain = append(chain, style)
+		id = ""
+		if style.BasedOn != nil {
+			id = style.BasedOn.Val
+		}
+	}
+	if len(chain) == 0 {
+		return nil
+	}
+
+	// Apply from the root of the chain down to the style itself
+	rp := &properties.RunProperties{}
+	for i := len(chain) - 1; i >= 0; i-- {
+		rp.Merge(chain[i].StyleRPr.runProperties())
+	}
+	return rp
+}
+
+// defaultParagraphStyleID returns the ID of the default paragraph style
+func (s *Styles) defaultParagraphStyleID() string {
+	for _, style := range s.Styles {
+		if style.Type == "paragraph" && style.Default == "1" {
+			return style.StyleId
+		}
+	}
+	return "Normal"
+}
+
+// find returns the style with the given ID, or nil
+func (s *Styles) find(styleID string) *Style {
+	for i := range s.Styles {
+		if s.Styles[i].StyleId == styleID {
+			return &s.Styles[i]
+		}
+	}
+	return nil
+}
+
+// runProperties converts style run formatting to RunProperties
+func (r *StyleRPr) runProperties() *properties.RunProperties {
+	rp := &properties.RunProperties{}
+	if r == nil {
+		return rp
+	}
+
+	on := true
+	if r.RFonts != nil {
+		rp.FontFamily = r.RFonts.Ascii
+	}
+	if r.Bold != nil {
+		rp.Bold = &on
+	}
+	if r.Italic != nil {
+		rp.Italic = &on
+	}
+	if r.Size != nil {
+		if halfPoints, err := strconv.ParseFloat(r.Size.Val, 64); err == nil {
+			rp.FontSize = halfPoints / 2
+		}
+	}
+	if r.Color != nil {
+		rp.Color = r.Color.Val
+	}
+	if r.Underline != nil {
+		rp.Underline = r.Underline.Val
+	}
+	if r.Lang != nil {
+		rp.Language = r.Lang.Val
+	}
+	return rp
+}
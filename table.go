@@ -1,6 +1,13 @@
 package mbadocx
 
-import "github.com/didikprabowo/mbadocx/elements"
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/didikprabowo/mbadocx/elements"
+)
 
 // AddTable creates and adds a new table with the specified dimensions to the document.
 //
@@ -40,7 +47,8 @@ import "github.com/didikprabowo/mbadocx/elements"
 //	table.SetWidth(100, elements.WidthPercent)
 //
 // Note: Empty tables are valid in Word documents. Cell indices are zero-based,
-// so a 3x4 table has rows 0-2 and columns 0-3.
+// so a 3x4 table has rows 0-2 and columns 0-3. Negative dimensions are treated
+// as 0; use AddTableChecked to reject invalid dimensions with an error.
 func (d *Document) AddTable(rows, cols int) *elements.Table {
 	// Create a new table element with specified dimensions
 	// The table maintains a reference to the document for style inheritance
@@ -54,6 +62,42 @@ func (d *Document) AddTable(rows, cols int) *elements.Table {
 	return tableElem
 }
 
+// AddTableChecked creates and adds a new table like AddTable, but reports
+// invalid dimensions instead of adding an empty or clamped table.
+//
+// Parameters:
+//   - rows: Number of rows in the table (must be > 0)
+//   - cols: Number of columns in the table (must be > 0)
+//
+// Returns:
+//   - *elements.Table: The newly created table, or nil on error
+//   - error: An error if the document has been closed or rows/cols are not positive
+//
+// Example:
+//
+//	table, err := doc.AddTableChecked(len(records), 3)
+//	if err != nil {
+//	    return fmt.Errorf("building report table: %w", err)
+//	}
+//	table.SetCellText(0, 0, "Name")
+func (d *Document) AddTableChecked(rows, cols int) (*elements.Table, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return nil, fmt.Errorf("document has been closed")
+	}
+
+	if rows <= 0 || cols <= 0 {
+		return nil, fmt.Errorf("invalid table dimensions %dx%d: rows and columns must be positive", rows, cols)
+	}
+
+	tableElem := elements.NewTable(d, rows, cols)
+	d.body.AddElement(tableElem)
+
+	return tableElem, nil
+}
+
 // AddTableWithData creates and populates a table from a 2D string array.
 //
 // This convenience method automatically determines table dimensions from the
@@ -232,3 +276,161 @@ func (d *Document) AddTableWithHeaders(headers []string, data [][]string) *eleme
 	d.body.AddElement(table)
 	return table
 }
+
+// RecordTableOptions controls how AddTableFromRecords formats values.
+// The zero value uses the defaults listed on each field.
+type RecordTableOptions struct {
+	TrueText   string // Text for true values (default "✓")
+	FalseText  string // Text for false values (default "✗")
+	NilText    string // Text for nil values (default "")
+	Precision  int    // Digits after the decimal point for floats; 0 or less prints the shortest exact form
+	DateLayout string // time.Time layout (default "2006-01-02")
+}
+
+// AddTableFromRecords creates a table with a bold header row and formats each
+// value according to its Go type.
+//
+// Numbers (all int, uint and float kinds) are right-aligned, booleans are
+// shown as check marks and centered, time.Time values use DateLayout, and
+// everything else is printed with fmt.Sprint. Headers of columns whose values
+// are all numbers are right-aligned too, so they line up with the figures.
+//
+// Parameters:
+//   - headers: Column header texts; determines the number of columns
+//   - rows: Data rows; extra values beyond len(headers) are ignored
+//   - opts: Formatting options, or nil for the defaults
+//
+// Returns:
+//   - *elements.Table: The created table, or nil if the document is closed or headers is empty
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	table := doc.AddTableFromRecords(
+//	    []string{"Product", "Price", "Stock", "Active"},
+//	    [][]interface{}{
+//	        {"Laptop", 999.5, 15, true},
+//	        {"Mouse", 25.0, 0, false},
+//	    },
+//	    &mbadocx.RecordTableOptions{Precision: 2},
+//	)
+//	table.SetStyle("TableGrid")
+func (d *Document) AddTableFromRecords(headers []string, rows [][]interface{}, opts *RecordTableOptions) *elements.Table {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed || len(headers) == 0 {
+		return nil
+	}
+
+	o := RecordTableOptions{TrueText: "✓", FalseText: "✗", DateLayout: "2006-01-02"}
+	if opts != nil {
+		o.NilText = opts.NilText
+		o.Precision = opts.Precision
+		if opts.TrueText != "" {
+			o.TrueText = opts.TrueText
+		}
+		if opts.FalseText != "" {
+			o.FalseText = opts.FalseText
+		}
+		if opts.DateLayout != "" {
+			o.DateLayout = opts.DateLayout
+		}
+	}
+
+	cols := len(headers)
+	table := elements.NewTable(d, len(rows)+1, cols)
+
+	// A column is numeric when it has at least one value and every value is a number
+	numeric := make([]bool, cols)
+	for j := range numeric {
+		seen := false
+		numeric[j] = true
+		for _, row := range rows {
+			if j >= len(row) || row[j] == nil {
+				continue
+			}
+			seen = true
+			if !isNumber(row[j]) {
+				numeric[j] = false
+				break
+			}
+		}
+		numeric[j] = numeric[j] && seen
+	}
+
+	for j, header := range headers {
+		_ = table.SetCellFormattedText(0, j, header, func(r *elements.Run) {
+			r.SetBold(true)
+		})
+		if numeric[j] {
+			table.Rows[0].Cells[j].Paragraphs[0].SetAlignment(elements.AlignmentRight)
+		}
+	}
+	_ = table.SetHeaderRow(0)
+
+	for i, row := range rows {
+		for j, value := range row {
+			if j >= cols {
+				break
+			}
+			text, align := formatRecordValue(value, o)
+			_ = table.SetCellText(i+1, j, text)
+			if align != "" {
+				table.Rows[i+1].Cells[j].Paragraphs[0].SetAlignment(align)
+			}
+		}
+	}
+
+	d.body.AddElement(table)
+	return table
+}
+
+// isNumber reports whether v is an integer or floating-point value
+func isNumber(v interface{}) bool {
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// formatRecordValue returns the cell text and alignment for a record value
+func formatRecordValue(v interface{}, o RecordTableOptions) (string, elements.ParagraphAlignment) {
+	if v == nil {
+		return o.NilText, ""
+	}
+
+	switch val := v.(type) {
+	case bool:
+		if val {
+			return o.TrueText, elements.AlignmentCenter
+		}
+		return o.FalseText, elements.AlignmentCenter
+	case time.Time:
+		return val.Format(o.DateLayout), ""
+	case fmt.Stringer:
+		return val.String(), ""
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), elements.AlignmentRight
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), elements.AlignmentRight
+	case reflect.Float32, reflect.Float64:
+		bits := 64
+		if rv.Kind() == reflect.Float32 {
+			bits = 32
+		}
+		if o.Precision > 0 {
+			return strconv.FormatFloat(rv.Float(), 'f', o.Precision, bits), elements.AlignmentRight
+		}
+		return strconv.FormatFloat(rv.Float(), 'f', -1, bits), elements.AlignmentRight
+	}
+
+	return fmt.Sprint(v), ""
+}
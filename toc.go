@@ -0,0 +1,57 @@
+package mbadocx
+
+import (
+	"fmt"
+
+	"github.com/didikprabowo/mbadocx/elements"
+)
+
+// AddTableOfContents inserts a clickable table of contents at the current position.
+//
+// Every heading up to maxLevel, both those already in the document and those
+// added later with AddHeading, is wrapped in a "_Toc" bookmark, and each TOC
+// entry is a hyperlink to that bookmark. The entries are written without page
+// numbers; the field is marked dirty and UpdateFieldsOnOpen is enabled so Word
+// rebuilds it with page numbers when the document is opened.
+//
+// Parameters:
+//   - maxLevel: Deepest heading level to list (1-9); out-of-range values use 3
+//
+// Returns:
+//   - *elements.TableOfContents: The table of contents, or nil if the document has been closed
+//
+// Example:
+//
+//	doc := mbadocx.New()
+//	doc.AddTableOfContents(2)
+//	doc.AddHeading("Introduction", 1)
+//	doc.AddHeading("Background", 2)
+func (d *Document) AddTableOfContents(maxLevel int) *elements.TableOfContents {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return nil
+	}
+
+	toc := elements.NewTableOfContents(d, maxLevel)
+	for _, el := range d.body.GetElements() {
+		if p, ok := el.(*elements.Paragraph); ok && toc.Includes(p) {
+			d.bookmarkHeading(p)
+		}
+	}
+
+	d.toc = toc
+	d.settings.UpdateFieldsOnOpen = true
+	d.body.AddElement(toc)
+	return toc
+}
+
+// bookmarkHeading wraps a heading in a TOC bookmark unless it already has one
+func (d *Document) bookmarkHeading(p *elements.Paragraph) {
+	if p.BookmarkWithPrefix(elements.TOCBookmarkPrefix) != "" {
+		return
+	}
+	d.bookmarkID++
+	p.AddBookmark(d.bookmarkID, fmt.Sprintf("%s%08d", elements.TOCBookmarkPrefix, d.bookmarkID))
+}
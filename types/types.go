@@ -1,9 +1,13 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	contenttypes "github.com/didikprabowo/mbadocx/content_types"
 	"github.com/didikprabowo/mbadocx/metadata"
+	"github.com/didikprabowo/mbadocx/properties"
 	"github.com/didikprabowo/mbadocx/relationships"
+	"github.com/didikprabowo/mbadocx/settings"
 	"github.com/didikprabowo/mbadocx/styles"
 )
 
@@ -14,7 +18,9 @@ type Document interface {
 	Metadata() Metadata
 	Styles() Styles
 	ContentTypes() ContentTypes
+	Settings() Settings
 	Media() []Media
+	Section() *properties.SectionProperties
 }
 
 type Media interface {
@@ -32,6 +38,10 @@ type Styles interface {
 	Get() *styles.Styles
 }
 
+type Settings interface {
+	Get() *settings.Settings
+}
+
 type Metadata interface {
 	Get() *metadata.Metadata
 }
@@ -52,4 +62,7 @@ type Relationships interface {
 	DocumentXML() ([]byte, error)
 	GetOrCreateHyperlink(url string) *relationships.Relationship
 	AddImage(filename string) *relationships.Relationship
+	AddImageData(data []byte, extension string) *relationships.Relationship
+	AddImageHash(sum [sha256.Size]byte, extension string) *relationships.Relationship
+	AddExternalImage(url string) *relationships.Relationship
 }
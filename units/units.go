@@ -0,0 +1,103 @@
+// Package units converts between the measurement units used in DOCX files.
+//
+// WordprocessingML mixes several units: twips (1/20 pt) for page layout, table
+// and paragraph measurements, half-points for font sizes and EMUs (English
+// Metric Units) for drawings. The typed values in this package make those
+// conversions explicit:
+//
+//	units.Inches(1.5).Twips()     // 2160
+//	units.Cm(2).EMU()             // 720000
+//	units.Points(11).HalfPoints() // 22
+package units
+
+import "math"
+
+// Conversion factors
+const (
+	TwipsPerInch  = 1440
+	TwipsPerPoint = 20
+	EMUPerInch    = 914400
+	EMUPerCm      = 360000
+	EMUPerPoint   = 12700
+	EMUPerTwip    = 635
+	EMUPerPixel   = 9525 // At 96 DPI
+	CmPerInch     = 2.54
+)
+
+// Twips is a length in twentieths of a point (1440 per inch)
+type Twips int
+
+// EMU is a length in English Metric Units (914400 per inch)
+type EMU int64
+
+// HalfPoints is a size in half-points, used for font sizes
+type HalfPoints int
+
+// Length is any measurement that can be expressed in twips and EMUs
+type Length interface {
+	Twips() Twips
+	EMU() EMU
+}
+
+var (
+	_ Length = Twips(0)
+	_ Length = EMU(0)
+	_ Length = Inches(0)
+	_ Length = Cm(0)
+	_ Length = Points(0)
+	_ Length = Pixels(0)
+)
+
+// Inches is a length in inches
+type Inches float64
+
+// Cm is a length in centimeters
+type Cm float64
+
+// Points is a length in points (72 per inch)
+type Points float64
+
+// Pixels is a length in pixels at 96 DPI
+type Pixels int
+
+// Twips returns the length itself
+func (t Twips) Twips() Twips { return t }
+
+// EMU converts twips to EMUs
+func (t Twips) EMU() EMU { return EMU(t) * EMUPerTwip }
+
+// Twips converts EMUs to twips
+func (e EMU) Twips() Twips { return Twips(math.Round(float64(e) / EMUPerTwip)) }
+
+// EMU returns the length itself
+func (e EMU) EMU() EMU { return e }
+
+// Twips converts inches to twips
+func (i Inches) Twips() Twips { return Twips(math.Round(float64(i) * TwipsPerInch)) }
+
+// EMU converts inches to EMUs
+func (i Inches) EMU() EMU { return EMU(math.Round(float64(i) * EMUPerInch)) }
+
+// Points converts inches to points
+func (i Inches) Points() Points { return Points(float64(i) * 72) }
+
+// Twips converts centimeters to twips
+func (c Cm) Twips() Twips { return Twips(math.Round(float64(c) / CmPerInch * TwipsPerInch)) }
+
+// EMU converts centimeters to EMUs
+func (c Cm) EMU() EMU { return EMU(math.Round(float64(c) * EMUPerCm)) }
+
+// Twips converts points to twips
+func (p Points) Twips() Twips { return Twips(math.Round(float64(p) * TwipsPerPoint)) }
+
+// EMU converts points to EMUs
+func (p Points) EMU() EMU { return EMU(math.Round(float64(p) * EMUPerPoint)) }
+
+// HalfPoints converts points to half-points
+func (p Points) HalfPoints() HalfPoints { return HalfPoints(math.Round(float64(p) * 2)) }
+
+// Twips converts pixels to twips
+func (px Pixels) Twips() Twips { return Twips(px) * 15 }
+
+// EMU converts pixels to EMUs
+func (px Pixels) EMU() EMU { return EMU(px) * EMUPerPixel }
@@ -5,7 +5,6 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
-	"log"
 	"strings"
 
 	"github.com/didikprabowo/mbadocx/types"
@@ -61,6 +60,7 @@ func (ap *AppProperties) Path() string {
 // Byte generates the XML content for docProps/app.xml
 func (ap *AppProperties) Byte() ([]byte, error) {
 	metadata := ap.document.Metadata().Get()
+	stats := CountStatistics(ap.document)
 
 	props := &AppPropertiesXML{
 		Xmlns:   "http://schemas.openxmlformats.org/officeDocument/2006/extended-properties",
@@ -69,11 +69,11 @@ func (ap *AppProperties) Byte() ([]byte, error) {
 		Application:          "Go DOCX Library",
 		AppVersion:           "1.0",
 		DocSecurity:          0,
-		Lines:                ap.countLines(),
-		Paragraphs:           ap.countParagraphs(),
-		Words:                ap.countWords(),
-		Characters:           ap.countCharacters(),
-		CharactersWithSpaces: ap.countCharactersWithSpaces(),
+		Lines:                stats.Lines,
+		Paragraphs:           stats.Paragraphs,
+		Words:                stats.Words,
+		Characters:           stats.Characters,
+		CharactersWithSpaces: stats.CharactersWithSpaces,
 		Pages:                1, // Approximation; Word will recalculate
 		Company:              metadata.Company,
 		Manager:              metadata.Manager,
@@ -93,9 +93,6 @@ func (ap *AppProperties) Byte() ([]byte, error) {
 		return nil, fmt.Errorf("encoding AppProperties XML: %w", err)
 	}
 
-	log.Printf("'%s' has been created.\n", ap.Path())
-	// log.Print(buf.String())
-
 	return buf.Bytes(), nil
 }
 
@@ -110,6 +107,27 @@ func (ap *AppProperties) WriteTo(w io.Writer) (int64, error) {
 	return int64(n), err
 }
 
+// Statistics holds the document counts written to docProps/app.xml
+type Statistics struct {
+	Lines                int
+	Paragraphs           int
+	Words                int
+	Characters           int
+	CharactersWithSpaces int
+}
+
+// CountStatistics computes the document statistics without writing the document
+func CountStatistics(document types.Document) Statistics {
+	ap := newAppProperties(document)
+	return Statistics{
+		Lines:                ap.countLines(),
+		Paragraphs:           ap.countParagraphs(),
+		Words:                ap.countWords(),
+		Characters:           ap.countCharacters(),
+		CharactersWithSpaces: ap.countCharactersWithSpaces(),
+	}
+}
+
 // countCharactersWithSpaces returns total character count including spaces
 func (ap *AppProperties) countCharactersWithSpaces() int {
 	total := 0
@@ -5,11 +5,18 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
-	"log"
+	"path"
+	"strings"
 
+	contenttypes "github.com/didikprabowo/mbadocx/content_types"
 	"github.com/didikprabowo/mbadocx/types"
 )
 
+// mediaTyped is implemented by media that know their MIME type, such as images
+type mediaTyped interface {
+	MediaType() string
+}
+
 var _ zipWritable = (*ContentTypesWr)(nil)
 
 // ContentTypes represents the [Content_Types].xml part in a DOCX package.
@@ -38,17 +45,34 @@ func (ct *ContentTypesWr) Byte() ([]byte, error) {
 	enc := xml.NewEncoder(&buf)
 	enc.Indent("", "  ")
 
-	contentTypes := ct.document.ContentTypes().Get()
+	contentTypes := ct.withMediaDefaults(ct.document.ContentTypes().Get())
 	if err := enc.Encode(contentTypes); err != nil {
 		return nil, fmt.Errorf("encoding ContentTypes XML: %w", err)
 	}
 
-	log.Printf("'%s' has been created.\n", ct.Path())
-	// log.Print(buf.String())
-
 	return buf.Bytes(), nil
 }
 
+// withMediaDefaults returns a copy of contentTypes with a Default for every media extension in the document
+func (ct *ContentTypesWr) withMediaDefaults(contentTypes *contenttypes.ContentTypes) *contenttypes.ContentTypes {
+	result := *contentTypes
+	result.Defaults = append([]contenttypes.Default(nil), contentTypes.Defaults...)
+
+	for _, media := range ct.document.Media() {
+		typed, ok := media.(mediaTyped)
+		if !ok {
+			continue
+		}
+		ext := strings.ToLower(strings.TrimPrefix(path.Ext(media.FileName()), "."))
+		if ext == "" {
+			continue
+		}
+		result.AddDefault(ext, typed.MediaType())
+	}
+
+	return &result
+}
+
 // WriteTo writes the XML to an io.Writer (implements io.WriterTo).
 func (ct *ContentTypesWr) WriteTo(w io.Writer) (int64, error) {
 	xmlData, err := ct.Byte()
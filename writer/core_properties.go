@@ -25,7 +25,6 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
-	"log"
 
 	"github.com/didikprabowo/mbadocx/types"
 )
@@ -128,9 +127,6 @@ func (cr *CoreProperties) Byte() ([]byte, error) {
 		return nil, fmt.Errorf("encoding ContentTypes XML: %w", err)
 	}
 
-	log.Printf("'%s' has been created.\n", cr.Path())
-	// log.Print(buf.String())
-
 	return buf.Bytes(), nil
 
 }
@@ -40,7 +40,6 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
-	"log"
 
 	"github.com/didikprabowo/mbadocx/types"
 )
@@ -70,13 +69,21 @@ func (d *Document) Byte() ([]byte, error) {
 	buf.WriteString(` xmlns:wp="http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing"`)
 	buf.WriteString(` xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main"`)
 	buf.WriteString(` xmlns:pic="http://schemas.openxmlformats.org/drawingml/2006/picture"`)
-	buf.WriteString(` xmlns:m="http://schemas.openxmlformats.org/officeDocument/2006/math">`)
+	buf.WriteString(` xmlns:m="http://schemas.openxmlformats.org/officeDocument/2006/math"`)
+	buf.WriteString(` xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006"`)
+	buf.WriteString(` xmlns:w14="http://schemas.microsoft.com/office/word/2010/wordml" mc:Ignorable="w14">`)
+
+	// Page background, must precede the body
+	if color := d.document.Settings().Get().PageColor; color != "" {
+		buf.WriteString(fmt.Sprintf(indent+"<w:background w:color=\"%s\"/>\n", color))
+	}
 
 	// Open body
 	// Write <w:body>
 	buf.WriteString(indent + "<w:body>\n")
 
-	for _, el := range d.document.Body().GetElements() {
+	elements := d.document.Body().GetElements()
+	for _, el := range elements {
 		xmlData, err := el.XML()
 		if err != nil {
 			return nil, fmt.Errorf("serialize element: %w", err)
@@ -93,13 +100,26 @@ func (d *Document) Byte() ([]byte, error) {
 		}
 	}
 
+	// A table may not be the last block in the body; Word expects a paragraph after it
+	if n := len(elements); n > 0 && elements[n-1].Type() == "table" {
+		buf.WriteString(indent + indent + "<w:p/>\n")
+	}
+
+	// Page setup of the final section, must be the last child of the body
+	if section := d.document.Section(); section != nil {
+		sectPr, err := section.XML()
+		if err != nil {
+			return nil, fmt.Errorf("serialize section properties: %w", err)
+		}
+		buf.WriteString(indent + indent)
+		buf.Write(sectPr)
+		buf.WriteString("\n")
+	}
+
 	// Close body and document
 	buf.WriteString(indent + "</w:body>\n")
 	buf.WriteString("</w:document>\n")
 
-	log.Printf("'%s' has been created.\n", d.Path())
-	// log.Print(buf.String())
-
 	return buf.Bytes(), nil
 }
 
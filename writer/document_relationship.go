@@ -3,7 +3,6 @@ package writer
 import (
 	"bytes"
 	"io"
-	"log"
 
 	"github.com/didikprabowo/mbadocx/types"
 )
@@ -43,9 +42,6 @@ func (d *DocumentRelationship) Byte() ([]byte, error) {
 	// Append the actual document XML
 	buf.Write(docXML)
 
-	log.Printf("'%s' has been created.\n", d.Path())
-	log.Print(buf.String())
-
 	return buf.Bytes(), nil
 }
 
@@ -2,9 +2,13 @@ package writer
 
 import (
 	"bytes"
+	"encoding/xml"
 	"fmt"
 	"io"
-	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/didikprabowo/mbadocx/types"
 )
 
 var _ zipWritable = (*NumberingDefinitions)(nil)
@@ -52,12 +56,102 @@ type LevelOverride struct {
 	StartOverride int
 }
 
-// NewNumberingDefinitions creates default numbering definitions
-func newNumberingDefinitions() *NumberingDefinitions {
-	return &NumberingDefinitions{
+// HeadingNumID is the numbering instance that heading styles link to for "1", "1.1", "1.1.1" numbering
+const HeadingNumID = 6
+
+// CustomListBaseID is the numbering instance ID of the first list a document defines itself
+const CustomListBaseID = HeadingNumID + 1
+
+// listDefiner is implemented by documents that define their own lists
+type listDefiner interface {
+	ListDefinitions() []AbstractNum
+}
+
+// numberedElement is implemented by elements that can reference numbering definitions
+type numberedElement interface {
+	UsesNumbering() bool
+}
+
+// NewNumberingDefinitions creates default numbering definitions, or an empty
+// numbering part when no element in the document uses a list
+func newNumberingDefinitions(document types.Document) *NumberingDefinitions {
+	if !hasNumberedElements(document) && !hasNumberedStyles(document) {
+		return &NumberingDefinitions{}
+	}
+
+	defs := &NumberingDefinitions{
 		AbstractNums: createDefaultAbstractNums(),
 		Nums:         createDefaultNums(),
 	}
+
+	// Document-defined lists follow the defaults, numbered from CustomListBaseID
+	if ld, ok := document.(listDefiner); ok {
+		nextAbstractID := len(defs.AbstractNums)
+		for i, an := range ld.ListDefinitions() {
+			an.ID = nextAbstractID + i
+			defs.AbstractNums = append(defs.AbstractNums, an)
+			defs.Nums = append(defs.Nums, Num{ID: CustomListBaseID + i, AbstractID: an.ID})
+		}
+	}
+	return defs
+}
+
+// hasNumberedElements reports whether any element of the body, or of a part
+// such as a header, footer or the footnotes, references a numbering definition
+func hasNumberedElements(document types.Document) bool {
+	for _, elem := range document.Body().GetElements() {
+		if n, ok := elem.(numberedElement); ok && n.UsesNumbering() {
+			return true
+		}
+	}
+	for _, media := range document.Media() {
+		if n, ok := media.(numberedElement); ok && n.UsesNumbering() {
+			return true
+		}
+	}
+	return false
+}
+
+// hasNumberedStyles reports whether any style links to a numbering definition
+func hasNumberedStyles(document types.Document) bool {
+	if document.Styles() == nil {
+		return false
+	}
+	for _, style := range document.Styles().Get().Styles {
+		if style.StylePPr != nil && style.StylePPr.NumPr != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// headingAbstractNum numbers Heading1-Heading9 hierarchically as 1, 1.1, 1.1.1 and so on
+func headingAbstractNum() AbstractNum {
+	an := AbstractNum{
+		ID:         5,
+		MultiLevel: true,
+		Name:       "Heading Numbering",
+	}
+	levelText := ""
+	for lvl := 0; lvl < 9; lvl++ {
+		if lvl > 0 {
+			levelText += "."
+		}
+		levelText += fmt.Sprintf("%%%d", lvl+1)
+		indent := 432 + lvl*144 // Number hangs at the margin, widening with each level
+		an.Levels = append(an.Levels, Level{
+			Level:         lvl,
+			Start:         1,
+			NumFormat:     "decimal",
+			LevelText:     levelText,
+			LevelJc:       "left",
+			PStyle:        fmt.Sprintf("Heading%d", lvl+1),
+			Suffix:        "tab",
+			IndentLeft:    indent,
+			IndentHanging: indent,
+		})
+	}
+	return an
 }
 
 func createDefaultAbstractNums() []AbstractNum {
@@ -283,17 +377,20 @@ func createDefaultAbstractNums() []AbstractNum {
 				},
 			},
 		},
+		// Abstract Num 5: Heading Numbering
+		headingAbstractNum(),
 	}
 }
 
 // createDefaultNums creates concrete numbering instances
 func createDefaultNums() []Num {
 	return []Num{
-		{ID: 1, AbstractID: 0}, // Bullet list
-		{ID: 2, AbstractID: 1}, // Decimal numbering
-		{ID: 3, AbstractID: 2}, // Legal numbering
-		{ID: 4, AbstractID: 3}, // Roman numerals
-		{ID: 5, AbstractID: 4}, // Custom symbols
+		{ID: 1, AbstractID: 0},            // Bullet list
+		{ID: 2, AbstractID: 1},            // Decimal numbering
+		{ID: 3, AbstractID: 2},            // Legal numbering
+		{ID: 4, AbstractID: 3},            // Roman numerals
+		{ID: 5, AbstractID: 4},            // Custom symbols
+		{ID: HeadingNumID, AbstractID: 5}, // Heading numbering
 	}
 }
 
@@ -329,9 +426,6 @@ func (num *NumberingDefinitions) Byte() ([]byte, error) {
 	// Close numbering element
 	buf.WriteString(`</w:numbering>`)
 
-	log.Printf("'%s' has been created.\n", num.Path())
-	// log.Print(buf.String())
-
 	return buf.Bytes(), nil
 }
 
@@ -378,7 +472,28 @@ func (an *AbstractNum) GenerateXML() string {
 	return buf.String()
 }
 
-// GenerateXML generates XML for a numbering level
+// levelPlaceholder matches the %1-%9 placeholders of a level text
+var levelPlaceholder = regexp.MustCompile(`%(\d)`)
+
+// Validate checks the level index and that the level text only refers to this level or the levels above it
+func (l *Level) Validate() error {
+	if l.Level < 0 || l.Level > 8 {
+		return fmt.Errorf("numbering level must be between 0 and 8: %d", l.Level)
+	}
+	if l.NumFormat == "" {
+		return fmt.Errorf("numbering level %d has no number format", l.Level)
+	}
+	for _, m := range levelPlaceholder.FindAllStringSubmatch(l.LevelText, -1) {
+		n, _ := strconv.Atoi(m[1])
+		if n < 1 || n > l.Level+1 {
+			return fmt.Errorf("level text %q of level %d refers to level %d; placeholders must be between %%1 and %%%d",
+				l.LevelText, l.Level, n, l.Level+1)
+		}
+	}
+	return nil
+}
+
+// GenerateXML generates XML for a numbering level, with the children in schema order
 func (l *Level) GenerateXML() string {
 	var buf bytes.Buffer
 
@@ -390,38 +505,36 @@ func (l *Level) GenerateXML() string {
 	buf.WriteString("\n")
 
 	// Number format
-	if l.NumFormat == "bullet" {
-		buf.WriteString(`      <w:numFmt w:val="bullet"/>`)
-		buf.WriteString("\n")
+	buf.WriteString(fmt.Sprintf(`      <w:numFmt w:val="%s"/>`, l.NumFormat))
+	buf.WriteString("\n")
 
-		// Level text for bullet
-		buf.WriteString(fmt.Sprintf(`      <w:lvlText w:val="%s"/>`, l.BulletChar))
+	// Paragraph style reference
+	if l.PStyle != "" {
+		buf.WriteString(fmt.Sprintf(`      <w:pStyle w:val="%s"/>`, l.PStyle))
 		buf.WriteString("\n")
+	}
 
-		// Font for bullet
-		if l.Font != "" {
-			buf.WriteString(`      <w:rPr>`)
-			buf.WriteString("\n")
-			buf.WriteString(fmt.Sprintf(`        <w:rFonts w:ascii="%s" w:hAnsi="%s" w:hint="default"/>`, l.Font, l.Font))
-			buf.WriteString("\n")
-			buf.WriteString(`      </w:rPr>`)
-			buf.WriteString("\n")
-		}
-	} else {
-		// Number format for numbered lists
-		buf.WriteString(fmt.Sprintf(`      <w:numFmt w:val="%s"/>`, l.NumFormat))
+	// Legal numbering
+	if l.IsLegalNum && l.NumFormat != "bullet" {
+		buf.WriteString(`      <w:isLgl/>`)
 		buf.WriteString("\n")
+	}
 
-		// Level text (e.g., "%1.", "%1.%2")
-		buf.WriteString(fmt.Sprintf(`      <w:lvlText w:val="%s"/>`, l.LevelText))
+	// Suffix (tab, space, or nothing)
+	if l.Suffix != "" {
+		buf.WriteString(fmt.Sprintf(`      <w:suff w:val="%s"/>`, l.Suffix))
 		buf.WriteString("\n")
+	}
 
-		// Legal numbering
-		if l.IsLegalNum {
-			buf.WriteString(`      <w:isLgl/>`)
-			buf.WriteString("\n")
-		}
+	// Level text: the bullet character, or placeholders such as "%1." and "%1.%2"
+	levelText := l.LevelText
+	if l.NumFormat == "bullet" {
+		levelText = l.BulletChar
 	}
+	buf.WriteString(`      <w:lvlText w:val="`)
+	_ = xml.EscapeText(&buf, []byte(levelText))
+	buf.WriteString(`"/>`)
+	buf.WriteString("\n")
 
 	// Level justification
 	buf.WriteString(fmt.Sprintf(`      <w:lvlJc w:val="%s"/>`, l.LevelJc))
@@ -438,15 +551,13 @@ func (l *Level) GenerateXML() string {
 	buf.WriteString(`      </w:pPr>`)
 	buf.WriteString("\n")
 
-	// Suffix (tab, space, or nothing)
-	if l.Suffix != "" {
-		buf.WriteString(fmt.Sprintf(`      <w:suff w:val="%s"/>`, l.Suffix))
+	// Font for bullet
+	if l.NumFormat == "bullet" && l.Font != "" {
+		buf.WriteString(`      <w:rPr>`)
 		buf.WriteString("\n")
-	}
-
-	// Paragraph style reference
-	if l.PStyle != "" {
-		buf.WriteString(fmt.Sprintf(`      <w:pStyle w:val="%s"/>`, l.PStyle))
+		buf.WriteString(fmt.Sprintf(`        <w:rFonts w:ascii="%s" w:hAnsi="%s" w:hint="default"/>`, l.Font, l.Font))
+		buf.WriteString("\n")
+		buf.WriteString(`      </w:rPr>`)
 		buf.WriteString("\n")
 	}
 
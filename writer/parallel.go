@@ -0,0 +1,53 @@
+package writer
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// generatedPart holds the serialized bytes of a package part
+type generatedPart struct {
+	path string
+	data []byte
+	err  error
+}
+
+// generateParts builds the bytes of every part concurrently, bounded by
+// GOMAXPROCS. The parts are independent, so only their output order matters;
+// results are returned in the same order as parts.
+func generateParts(ctx context.Context, parts []zipWritable) []generatedPart {
+	results := make([]generatedPart, len(parts))
+
+	workers := runtime.GOMAXPROCS(0)
+	if workers > len(parts) {
+		workers = len(parts)
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				part := parts[idx]
+				results[idx].path = part.Path()
+				if err := ctx.Err(); err != nil {
+					results[idx].err = err
+					continue
+				}
+				results[idx].data, results[idx].err = part.Byte()
+			}
+		}()
+	}
+
+	for idx := range parts {
+		jobs <- idx
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
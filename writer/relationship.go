@@ -5,7 +5,6 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
-	"log"
 
 	"github.com/didikprabowo/mbadocx/types"
 )
@@ -41,9 +40,6 @@ func (r *Relationships) Byte() ([]byte, error) {
 	buf.WriteString(xml.Header)
 	buf.Write(relsXML)
 
-	log.Printf("'%s' has been created.\n", r.Path())
-	// log.Print(buf.String())
-
 	return buf.Bytes(), nil
 }
 
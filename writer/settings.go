@@ -0,0 +1,203 @@
+package writer
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/didikprabowo/mbadocx/settings"
+	"github.com/didikprabowo/mbadocx/types"
+)
+
+var _ zipWritable = (*SettingsWr)(nil)
+
+// settingsXML is the root of word/settings.xml. Field order follows CT_Settings.
+type settingsXML struct {
+	XMLName                 xml.Name         `xml:"w:settings"`
+	XmlnsW                  string           `xml:"xmlns:w,attr"`
+	Zoom                    *settingsZoom    `xml:"w:zoom,omitempty"`
+	DisplayBackgroundShape  *settingsOnOff   `xml:"w:displayBackgroundShape,omitempty"`
+	ProofState              *settingsProof   `xml:"w:proofState,omitempty"`
+	DefaultTabStop          *settingsVal     `xml:"w:defaultTabStop,omitempty"`
+	AutoHyphenation         *settingsOnOff   `xml:"w:autoHyphenation,omitempty"`
+	CharacterSpacingControl *settingsVal     `xml:"w:characterSpacingControl,omitempty"`
+	StrictFirstAndLastChars *settingsOnOff   `xml:"w:strictFirstAndLastChars,omitempty"`
+	NoLineBreaksAfter       *settingsKinsoku `xml:"w:noLineBreaksAfter,omitempty"`
+	NoLineBreaksBefore      *settingsKinsoku `xml:"w:noLineBreaksBefore,omitempty"`
+	UpdateFields            *settingsVal     `xml:"w:updateFields,omitempty"`
+	Compat                  *settingsCompat  `xml:"w:compat,omitempty"`
+	ThemeFontLang           *settingsLang    `xml:"w:themeFontLang,omitempty"`
+	DecimalSymbol           *settingsVal     `xml:"w:decimalSymbol,omitempty"`
+	ListSeparator           *settingsVal     `xml:"w:listSeparator,omitempty"`
+}
+
+type settingsOnOff struct{}
+
+type settingsVal struct {
+	Val string `xml:"w:val,attr"`
+}
+
+type settingsZoom struct {
+	Percent string `xml:"w:percent,attr"`
+}
+
+// settingsCompat is the w:compat block. Legacy flags precede compatSetting per CT_Compat.
+type settingsCompat struct {
+	AdjustLineHeightInTable *settingsOnOff          `xml:"w:adjustLineHeightInTable,omitempty"`
+	DoNotBreakWrappedTables *settingsOnOff          `xml:"w:doNotBreakWrappedTables,omitempty"`
+	CompatSettings          []settingsCompatSetting `xml:"w:compatSetting"`
+}
+
+type settingsCompatSetting struct {
+	Name string `xml:"w:name,attr"`
+	URI  string `xml:"w:uri,attr"`
+	Val  string `xml:"w:val,attr"`
+}
+
+type settingsProof struct {
+	Spelling string `xml:"w:spelling,attr,omitempty"`
+	Grammar  string `xml:"w:grammar,attr,omitempty"`
+}
+
+type settingsKinsoku struct {
+	Lang string `xml:"w:lang,attr"`
+	Val  string `xml:"w:val,attr"`
+}
+
+type settingsLang struct {
+	Val      string `xml:"w:val,attr,omitempty"`
+	EastAsia string `xml:"w:eastAsia,attr,omitempty"`
+}
+
+type SettingsWr struct {
+	// document
+	document types.Document
+}
+
+// newSettingsWr
+func newSettingsWr(document types.Document) *SettingsWr {
+	return &SettingsWr{document: document}
+}
+
+// Path
+func (swr *SettingsWr) Path() string {
+	return "word/settings.xml"
+}
+
+// Byte
+func (swr *SettingsWr) Byte() ([]byte, error) {
+	var buf bytes.Buffer
+
+	// Write XML declaration
+	buf.WriteString(xml.Header)
+
+	// Encode the struct
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+
+	if err := enc.Encode(mapSettings(swr.document.Settings().Get())); err != nil {
+		return nil, fmt.Errorf("encoding Settings XML: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// WriteTo
+func (swr *SettingsWr) WriteTo(w io.Writer) (int64, error) {
+	xmlData, err := swr.Byte()
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := w.Write(xmlData)
+	return int64(n), err
+}
+
+// mapSettings converts the document settings into their XML representation
+func mapSettings(s *settings.Settings) *settingsXML {
+	x := &settingsXML{
+		XmlnsW:                  "http://schemas.openxmlformats.org/wordprocessingml/2006/main",
+		CharacterSpacingControl: &settingsVal{Val: "doNotCompress"},
+		Compat:                  mapCompatibilitySettings(s),
+		ThemeFontLang:           mapLanguageSettings(s),
+		DecimalSymbol:           &settingsVal{Val: "."},
+		ListSeparator:           &settingsVal{Val: ","},
+	}
+
+	if s.Zoom > 0 {
+		x.Zoom = &settingsZoom{Percent: strconv.Itoa(s.Zoom)}
+	}
+
+	if s.DisplayBackgroundShape || s.PageColor != "" {
+		x.DisplayBackgroundShape = &settingsOnOff{}
+	}
+
+	// Only emit proofing state when it was configured explicitly
+	if s.SpellingState != "" || s.GrammarState != "" {
+		x.ProofState = &settingsProof{Spelling: s.SpellingState, Grammar: s.GrammarState}
+	}
+
+	if s.DefaultTabStop > 0 {
+		x.DefaultTabStop = &settingsVal{Val: strconv.Itoa(s.DefaultTabStop)}
+	}
+
+	if s.AutoHyphenation {
+		x.AutoHyphenation = &settingsOnOff{}
+	}
+
+	if s.StrictFirstAndLastChars {
+		x.StrictFirstAndLastChars = &settingsOnOff{}
+	}
+
+	x.NoLineBreaksAfter = mapKinsoku(s.NoLineBreaksAfter)
+	x.NoLineBreaksBefore = mapKinsoku(s.NoLineBreaksBefore)
+
+	if s.UpdateFieldsOnOpen {
+		x.UpdateFields = &settingsVal{Val: "true"}
+	}
+
+	return x
+}
+
+// mapCompatibilitySettings returns the compat block for the configured compatibility mode and flags
+func mapCompatibilitySettings(s *settings.Settings) *settingsCompat {
+	if s.CompatibilityMode == 0 && !s.AdjustLineHeightInTable && !s.DoNotBreakWrappedTables {
+		return nil
+	}
+
+	compat := &settingsCompat{}
+
+	if s.AdjustLineHeightInTable {
+		compat.AdjustLineHeightInTable = &settingsOnOff{}
+	}
+
+	if s.DoNotBreakWrappedTables {
+		compat.DoNotBreakWrappedTables = &settingsOnOff{}
+	}
+
+	if s.CompatibilityMode > 0 {
+		compat.CompatSettings = append(compat.CompatSettings, settingsCompatSetting{
+			Name: "compatibilityMode", URI: "http://schemas.microsoft.com/office/word", Val: strconv.Itoa(s.CompatibilityMode),
+		})
+	}
+
+	return compat
+}
+
+// mapLanguageSettings returns the theme font language
+func mapLanguageSettings(s *settings.Settings) *settingsLang {
+	if s.Language == "" && s.EastAsianLanguage == "" {
+		return nil
+	}
+	return &settingsLang{Val: s.Language, EastAsia: s.EastAsianLanguage}
+}
+
+// mapKinsoku returns a custom line breaking rule, or nil when none is set
+func mapKinsoku(k *settings.Kinsoku) *settingsKinsoku {
+	if k == nil || k.Lang == "" || k.Characters == "" {
+		return nil
+	}
+	return &settingsKinsoku{Lang: k.Lang, Val: k.Characters}
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
-	"log"
 
 	"github.com/didikprabowo/mbadocx/types"
 )
@@ -43,9 +42,6 @@ func (swr *StylesWr) Byte() ([]byte, error) {
 		return nil, fmt.Errorf("encoding ContentTypes XML: %w", err)
 	}
 
-	log.Printf("'%s' has been created.\n", swr.Path())
-	// log.Print(buf.String())
-
 	return buf.Bytes(), nil
 }
 
@@ -20,9 +20,9 @@ package writer
 import (
 	"archive/zip"
 	"compress/flate"
+	"context"
 	"fmt"
 	"io"
-	"log"
 	"time"
 
 	"github.com/didikprabowo/mbadocx/types"
@@ -34,8 +34,20 @@ type Writer struct {
 	zipWriter  *zip.Writer
 	mediaFiles map[string][]byte
 	options    SaveOptions
+	logger     Logger
 }
 
+// Logger receives progress messages while a document is written.
+// *log.Logger satisfies this interface.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// noopLogger discards every message
+type noopLogger struct{}
+
+func (noopLogger) Printf(string, ...interface{}) {}
+
 // SaveOptions provides options for saving documents
 type SaveOptions struct {
 	CompressionLevel        int
@@ -62,7 +74,17 @@ func NewWriter(doc types.Document) *Writer {
 		document:   doc,
 		mediaFiles: make(map[string][]byte),
 		options:    DefaultSaveOptions(),
+		logger:     noopLogger{},
+	}
+}
+
+// SetLogger sets the logger used to report written parts; nil disables logging
+func (w *Writer) SetLogger(logger Logger) *Writer {
+	if logger == nil {
+		logger = noopLogger{}
 	}
+	w.logger = logger
+	return w
 }
 
 // WriteToZip writes any ZipWritable part to a zip.Writer
@@ -75,17 +97,72 @@ func (w *Writer) writeToZip(part zipWritable) error {
 	return err
 }
 
+// mediaStreamer is implemented by media that stream their content at write time
+// instead of holding it in memory, such as images from NewImageStream
+type mediaStreamer interface {
+	Open() (io.ReadCloser, error)
+}
+
+// mediaBuilder is implemented by parts generated at write time, such as
+// footnotes, whose content can fail to build
+type mediaBuilder interface {
+	Content() ([]byte, error)
+}
+
+// writeMedia writes a media part, streaming it when supported
+func (w *Writer) writeMedia(name string, media types.Media) error {
+	if builder, ok := media.(mediaBuilder); ok {
+		data, err := builder.Content()
+		if err != nil {
+			return err
+		}
+		return w.writeFile(name, data)
+	}
+
+	streamer, ok := media.(mediaStreamer)
+	if !ok {
+		return w.writeFile(name, media.RawContent())
+	}
+
+	rc, err := streamer.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	writer, err := w.zipWriter.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(writer, rc)
+	return err
+}
+
 func (w *Writer) writeFile(name string, data []byte) error {
-	writer, _ := w.zipWriter.Create(name)
-	_, err := writer.Write(data)
+	writer, err := w.zipWriter.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(data)
 	return err
 }
 
 // Write writes the document to an io.Writer
 func (w *Writer) Write(writer io.Writer) error {
+	return w.WriteContext(context.Background(), writer)
+}
+
+// WriteContext writes the document to an io.Writer, checking ctx between zip entries
+// so that a cancelled context stops generation promptly
+func (w *Writer) WriteContext(ctx context.Context, writer io.Writer) error {
 	w.zipWriter = zip.NewWriter(writer)
+	// On the error paths the archive is abandoned; the success path closes it
+	// explicitly so a failure to write the central directory is reported
+	finished := false
 	defer func() {
-		_ = w.zipWriter.Close()
+		if !finished {
+			_ = w.zipWriter.Close()
+		}
 	}()
 
 	// Set compression level if specified
@@ -104,26 +181,42 @@ func (w *Writer) Write(writer io.Writer) error {
 		newDocument(w.document),             // word/document.xml
 		newCoreProperties(w.document),       // docProps/core.xml
 		newAppProperties(w.document),        // docProps/app.xml
-		newNumberingDefinitions(),           // word/numbering.xml
-		newStylesWr(w.document),
+		newNumberingDefinitions(w.document), // word/numbering.xml
+		newStylesWr(w.document),             // word/styles.xml
+		newSettingsWr(w.document),           // word/settings.xml
 		// Add others like styles, header/footer, etc.
 	)
 
-	// Write each component
-	for _, part := range components {
-		if err := w.writeToZip(part); err != nil {
-			return fmt.Errorf("write %s: %w", part.Path(), err)
+	// Generate the parts concurrently, then write them in order
+	for _, part := range generateParts(ctx, components) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if part.err != nil {
+			return fmt.Errorf("write %s: %w", part.path, part.err)
+		}
+		if err := w.writeFile(part.path, part.data); err != nil {
+			return fmt.Errorf("write %s: %w", part.path, err)
 		}
+		w.logger.Printf("'%s' has been created.", part.path)
 	}
 
 	// Write file
 	// word/media/*
 	for _, media := range w.document.Media() {
-		err := w.writeFile(media.TargetPath()+media.FileName(), media.RawContent())
-		if err != nil {
-			log.Print(err.Error())
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		path := media.TargetPath() + media.FileName()
+		if err := w.writeMedia(path, media); err != nil {
+			return fmt.Errorf("write %s: %w", path, err)
 		}
-		log.Printf("'%s' has been created.\n", media.TargetPath()+media.FileName())
+		w.logger.Printf("'%s' has been created.", path)
+	}
+
+	finished = true
+	if err := w.zipWriter.Close(); err != nil {
+		return fmt.Errorf("finish zip archive: %w", err)
 	}
 
 	return nil